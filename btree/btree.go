@@ -0,0 +1,449 @@
+package btree
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"sort"
+)
+
+// Entry is a key/value pair, used for bulk loading.
+type Entry[K any, V any] struct {
+	Key   K
+	Value V
+}
+
+type node[K any, V any] struct {
+	keys     []K
+	values   []V
+	children []*node[K, V]
+}
+
+func (n *node[K, V]) leaf() bool {
+	return len(n.children) == 0
+}
+
+// BTree is an ordered map stored in a B-tree of minimum degree t: every node
+// but the root holds between t-1 and 2t-1 keys in one contiguous slice, so a
+// lookup touches O(log_t n) nodes instead of O(log n) pointers. It is not safe
+// for concurrent use, and must not be modified while an iterator is running.
+type BTree[K any, V any] struct {
+	root    *node[K, V]
+	degree  int
+	length  int
+	compare func(a, b K) int
+}
+
+// NewBTree creates an empty tree of the given minimum degree; degrees below 2
+// are raised to 2.
+func NewBTree[K cmp.Ordered, V any](degree int) *BTree[K, V] {
+	return NewBTreeFunc[K, V](degree, cmp.Compare[K])
+}
+
+// NewBTreeFunc orders keys with compare, which returns a negative number, zero
+// or a positive number as a is less than, equal to or greater than b.
+func NewBTreeFunc[K any, V any](degree int, compare func(a, b K) int) *BTree[K, V] {
+	return &BTree[K, V]{degree: max(degree, 2), compare: compare}
+}
+
+// NewBTreeFromSorted builds a tree from entries in strictly increasing key
+// order in O(n), packing nodes instead of inserting one entry at a time.
+func NewBTreeFromSorted[K cmp.Ordered, V any](degree int, entries []Entry[K, V]) (*BTree[K, V], error) {
+	return NewBTreeFromSortedFunc(degree, cmp.Compare[K], entries)
+}
+
+func NewBTreeFromSortedFunc[K any, V any](degree int, compare func(a, b K) int, entries []Entry[K, V]) (*BTree[K, V], error) {
+	t := NewBTreeFunc[K, V](degree, compare)
+	for i := 1; i < len(entries); i++ {
+		if compare(entries[i-1].Key, entries[i].Key) >= 0 {
+			return nil, fmt.Errorf("btree: keys not strictly increasing at index %d", i)
+		}
+	}
+	if len(entries) == 0 {
+		return t, nil
+	}
+	height := 1
+	for t.maxEntries(height) < len(entries) {
+		height++
+	}
+	t.root = t.build(entries, height, true)
+	t.length = len(entries)
+	return t, nil
+}
+
+func (t *BTree[K, V]) maxKeys() int {
+	return 2*t.degree - 1
+}
+
+// maxEntries is the capacity of a subtree of the given height: (2t)^h - 1.
+func (t *BTree[K, V]) maxEntries(height int) int {
+	n := 1
+	for i := 0; i < height; i++ {
+		n *= 2 * t.degree
+	}
+	return n - 1
+}
+
+// minEntries is the least a non-root subtree of the given height holds: t^h - 1.
+func (t *BTree[K, V]) minEntries(height int) int {
+	n := 1
+	for i := 0; i < height; i++ {
+		n *= t.degree
+	}
+	return n - 1
+}
+
+// build packs entries into a subtree of exactly the given height, using as
+// few children per node as the capacity of the level below allows, so nodes
+// end up as full as possible while every child keeps its minimum.
+func (t *BTree[K, V]) build(entries []Entry[K, V], height int, root bool) *node[K, V] {
+	n := &node[K, V]{}
+	if height == 1 {
+		for _, e := range entries {
+			n.keys = append(n.keys, e.Key)
+			n.values = append(n.values, e.Value)
+		}
+		return n
+	}
+	total := len(entries)
+	children := (total + 1 + t.maxEntries(height-1)) / (t.maxEntries(height-1) + 1)
+	if root {
+		children = max(children, 2)
+	} else {
+		children = max(children, t.degree)
+	}
+	children = min(children, (total+1)/(t.minEntries(height-1)+1))
+
+	rest := total - (children - 1)
+	start := 0
+	for i := 0; i < children; i++ {
+		size := rest / children
+		if i < rest%children {
+			size++
+		}
+		n.children = append(n.children, t.build(entries[start:start+size], height-1, false))
+		start += size
+		if i < children-1 {
+			n.keys = append(n.keys, entries[start].Key)
+			n.values = append(n.values, entries[start].Value)
+			start++
+		}
+	}
+	return n
+}
+
+// search returns the position of the first key in n that is >= key and
+// whether it equals key.
+func (t *BTree[K, V]) search(n *node[K, V], key K) (int, bool) {
+	i := sort.Search(len(n.keys), func(i int) bool { return t.compare(n.keys[i], key) >= 0 })
+	return i, i < len(n.keys) && t.compare(n.keys[i], key) == 0
+}
+
+func (t *BTree[K, V]) Get(key K) (V, bool) {
+	for n := t.root; n != nil; {
+		i, found := t.search(n, key)
+		if found {
+			return n.values[i], true
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+	var zero V
+	return zero, false
+}
+
+func (t *BTree[K, V]) Contains(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Insert stores value under key and reports whether the key is new.
+func (t *BTree[K, V]) Insert(key K, value V) bool {
+	if t.root == nil {
+		t.root = &node[K, V]{keys: []K{key}, values: []V{value}}
+		t.length++
+		return true
+	}
+	if len(t.root.keys) == t.maxKeys() {
+		old := t.root
+		t.root = &node[K, V]{children: []*node[K, V]{old}}
+		t.splitChild(t.root, 0)
+	}
+	added := t.insertNonFull(t.root, key, value)
+	if added {
+		t.length++
+	}
+	return added
+}
+
+// insertNonFull descends from a node with room for one more key, splitting
+// full children on the way so a split never has to propagate upwards.
+func (t *BTree[K, V]) insertNonFull(n *node[K, V], key K, value V) bool {
+	for {
+		i, found := t.search(n, key)
+		if found {
+			n.values[i] = value
+			return false
+		}
+		if n.leaf() {
+			n.keys = insertAt(n.keys, i, key)
+			n.values = insertAt(n.values, i, value)
+			return true
+		}
+		if len(n.children[i].keys) == t.maxKeys() {
+			t.splitChild(n, i)
+			switch c := t.compare(key, n.keys[i]); {
+			case c == 0:
+				n.values[i] = value
+				return false
+			case c > 0:
+				i++
+			}
+		}
+		n = n.children[i]
+	}
+}
+
+// splitChild moves the median key of the full child i up into n and the keys
+// above it into a new sibling.
+func (t *BTree[K, V]) splitChild(n *node[K, V], i int) {
+	child := n.children[i]
+	mid := t.degree - 1
+	sibling := &node[K, V]{
+		keys:   append([]K(nil), child.keys[mid+1:]...),
+		values: append([]V(nil), child.values[mid+1:]...),
+	}
+	if !child.leaf() {
+		sibling.children = append([]*node[K, V](nil), child.children[mid+1:]...)
+		clear(child.children[mid+1:])
+		child.children = child.children[:mid+1]
+	}
+	n.keys = insertAt(n.keys, i, child.keys[mid])
+	n.values = insertAt(n.values, i, child.values[mid])
+	n.children = insertAt(n.children, i+1, sibling)
+	clear(child.keys[mid:])
+	clear(child.values[mid:])
+	child.keys = child.keys[:mid]
+	child.values = child.values[:mid]
+}
+
+// Delete removes key and reports whether it was present.
+func (t *BTree[K, V]) Delete(key K) bool {
+	if t.root == nil {
+		return false
+	}
+	removed := t.delete(t.root, key)
+	if len(t.root.keys) == 0 {
+		if t.root.leaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+	if removed {
+		t.length--
+	}
+	return removed
+}
+
+// delete removes key from the subtree at n, which has at least t keys unless
+// it is the root, topping children up before descending into them.
+func (t *BTree[K, V]) delete(n *node[K, V], key K) bool {
+	i, found := t.search(n, key)
+	if n.leaf() {
+		if !found {
+			return false
+		}
+		n.keys = removeAt(n.keys, i)
+		n.values = removeAt(n.values, i)
+		return true
+	}
+	if found {
+		switch {
+		case len(n.children[i].keys) >= t.degree:
+			pred := n.children[i]
+			for !pred.leaf() {
+				pred = pred.children[len(pred.children)-1]
+			}
+			last := len(pred.keys) - 1
+			n.keys[i], n.values[i] = pred.keys[last], pred.values[last]
+			return t.delete(n.children[i], pred.keys[last])
+		case len(n.children[i+1].keys) >= t.degree:
+			succ := n.children[i+1]
+			for !succ.leaf() {
+				succ = succ.children[0]
+			}
+			n.keys[i], n.values[i] = succ.keys[0], succ.values[0]
+			return t.delete(n.children[i+1], succ.keys[0])
+		default:
+			t.merge(n, i)
+			return t.delete(n.children[i], key)
+		}
+	}
+	if len(n.children[i].keys) < t.degree {
+		i = t.fill(n, i)
+	}
+	return t.delete(n.children[i], key)
+}
+
+// fill gives child i of n at least t keys by borrowing from a sibling or
+// merging with one, and returns the index of the child now covering its range.
+func (t *BTree[K, V]) fill(n *node[K, V], i int) int {
+	child := n.children[i]
+	switch {
+	case i > 0 && len(n.children[i-1].keys) >= t.degree:
+		left := n.children[i-1]
+		last := len(left.keys) - 1
+		child.keys = insertAt(child.keys, 0, n.keys[i-1])
+		child.values = insertAt(child.values, 0, n.values[i-1])
+		n.keys[i-1], n.values[i-1] = left.keys[last], left.values[last]
+		left.keys = removeAt(left.keys, last)
+		left.values = removeAt(left.values, last)
+		if !left.leaf() {
+			child.children = insertAt(child.children, 0, left.children[last+1])
+			left.children = removeAt(left.children, last+1)
+		}
+		return i
+	case i < len(n.keys) && len(n.children[i+1].keys) >= t.degree:
+		right := n.children[i+1]
+		child.keys = append(child.keys, n.keys[i])
+		child.values = append(child.values, n.values[i])
+		n.keys[i], n.values[i] = right.keys[0], right.values[0]
+		right.keys = removeAt(right.keys, 0)
+		right.values = removeAt(right.values, 0)
+		if !right.leaf() {
+			child.children = append(child.children, right.children[0])
+			right.children = removeAt(right.children, 0)
+		}
+		return i
+	case i < len(n.keys):
+		t.merge(n, i)
+		return i
+	default:
+		t.merge(n, i-1)
+		return i - 1
+	}
+}
+
+// merge folds key i of n and child i+1 into child i.
+func (t *BTree[K, V]) merge(n *node[K, V], i int) {
+	child, sibling := n.children[i], n.children[i+1]
+	child.keys = append(append(child.keys, n.keys[i]), sibling.keys...)
+	child.values = append(append(child.values, n.values[i]), sibling.values...)
+	child.children = append(child.children, sibling.children...)
+	n.keys = removeAt(n.keys, i)
+	n.values = removeAt(n.values, i)
+	n.children = removeAt(n.children, i+1)
+}
+
+func insertAt[T any](s []T, i int, v T) []T {
+	var zero T
+	s = append(s, zero)
+	copy(s[i+1:], s[i:])
+	s[i] = v
+	return s
+}
+
+func removeAt[T any](s []T, i int) []T {
+	copy(s[i:], s[i+1:])
+	var zero T
+	s[len(s)-1] = zero
+	return s[:len(s)-1]
+}
+
+func (t *BTree[K, V]) Len() int {
+	return t.length
+}
+
+func (t *BTree[K, V]) IsEmpty() bool {
+	return t.length == 0
+}
+
+func (t *BTree[K, V]) Clear() {
+	t.root = nil
+	t.length = 0
+}
+
+// Height returns the number of levels; an empty tree has height 0.
+func (t *BTree[K, V]) Height() int {
+	height := 0
+	for n := t.root; n != nil; height++ {
+		if n.leaf() {
+			return height + 1
+		}
+		n = n.children[0]
+	}
+	return height
+}
+
+func (t *BTree[K, V]) Min() (K, V, bool) {
+	if t.root == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	n := t.root
+	for !n.leaf() {
+		n = n.children[0]
+	}
+	return n.keys[0], n.values[0], true
+}
+
+func (t *BTree[K, V]) Max() (K, V, bool) {
+	if t.root == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	n := t.root
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+	last := len(n.keys) - 1
+	return n.keys[last], n.values[last], true
+}
+
+// All yields every entry in ascending key order.
+func (t *BTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if t.root != nil {
+			t.walk(t.root, nil, nil, yield)
+		}
+	}
+}
+
+// Range yields the entries with lo <= key <= hi in ascending key order,
+// skipping every subtree that lies before lo.
+func (t *BTree[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if t.root != nil {
+			t.walk(t.root, &lo, &hi, yield)
+		}
+	}
+}
+
+// walk reports false once iteration should stop, either because yield asked
+// to or because a key beyond hi was reached.
+func (t *BTree[K, V]) walk(n *node[K, V], lo, hi *K, yield func(K, V) bool) bool {
+	start := 0
+	if lo != nil {
+		start, _ = t.search(n, *lo)
+	}
+	for i := start; i < len(n.keys); i++ {
+		if !n.leaf() && !t.walk(n.children[i], lo, hi, yield) {
+			return false
+		}
+		if hi != nil && t.compare(n.keys[i], *hi) > 0 {
+			return false
+		}
+		if !yield(n.keys[i], n.values[i]) {
+			return false
+		}
+	}
+	if !n.leaf() {
+		return t.walk(n.children[len(n.keys)], lo, hi, yield)
+	}
+	return true
+}
@@ -0,0 +1,95 @@
+package btree
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"testing"
+
+	"github.com/raj1kshtz/go-structurarium/tree"
+)
+
+// Benchmarks against tree.GenericBST, reached through its public BSTWrapper.
+// Run with: go test -bench . -run '^$' ./btree
+
+const benchSize = 10000
+
+func benchKeys() []int {
+	return rand.New(rand.NewPCG(1, 2)).Perm(benchSize)
+}
+
+func BenchmarkInsert(b *testing.B) {
+	keys := benchKeys()
+	for _, degree := range []int{2, 16, 64} {
+		b.Run("BTree/degree="+strconv.Itoa(degree), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				t := NewBTree[int, int](degree)
+				for _, k := range keys {
+					t.Insert(k, k)
+				}
+			}
+		})
+	}
+	b.Run("GenericBST", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			bst := tree.NewBSTWrapper[int]()
+			for _, k := range keys {
+				bst.Insert(k)
+			}
+		}
+	})
+}
+
+func BenchmarkBulkLoad(b *testing.B) {
+	entries := make([]Entry[int, int], benchSize)
+	for i := range entries {
+		entries[i] = Entry[int, int]{Key: i, Value: i}
+	}
+	for i := 0; i < b.N; i++ {
+		if _, err := NewBTreeFromSorted(32, entries); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	keys := benchKeys()
+	t := NewBTree[int, int](32)
+	bst := tree.NewBSTWrapper[int]()
+	for _, k := range keys {
+		t.Insert(k, k)
+		bst.Insert(k)
+	}
+	b.Run("BTree", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			t.Get(keys[i%benchSize])
+		}
+	})
+	b.Run("GenericBST", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			bst.Search(keys[i%benchSize])
+		}
+	})
+}
+
+func BenchmarkRangeScan(b *testing.B) {
+	keys := benchKeys()
+	t := NewBTree[int, int](32)
+	bst := tree.NewBSTWrapper[int]()
+	for _, k := range keys {
+		t.Insert(k, k)
+		bst.Insert(k)
+	}
+	b.Run("BTree", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			lo := i % (benchSize - 100)
+			for range t.Range(lo, lo+99) {
+			}
+		}
+	})
+	b.Run("GenericBST", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			lo := i % (benchSize - 100)
+			bst.Range(lo, lo+99)
+		}
+	})
+}
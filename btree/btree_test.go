@@ -0,0 +1,238 @@
+package btree
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BTreeTestSuite struct {
+	suite.Suite
+}
+
+func TestBTreeTestSuite(t *testing.T) {
+	suite.Run(t, new(BTreeTestSuite))
+}
+
+// checkInvariants verifies key order, node occupancy and that every leaf sits
+// at the same depth, returning the number of keys.
+func (s *BTreeTestSuite) checkInvariants(t *BTree[int, int]) int {
+	if t.root == nil {
+		s.Equal(0, t.Len())
+		return 0
+	}
+	leafDepth := -1
+	var check func(n *node[int, int], depth int, lo, hi *int) int
+	check = func(n *node[int, int], depth int, lo, hi *int) int {
+		s.LessOrEqual(len(n.keys), 2*t.degree-1)
+		if n != t.root {
+			s.GreaterOrEqual(len(n.keys), t.degree-1)
+		}
+		s.Len(n.values, len(n.keys))
+		s.True(slices.IsSorted(n.keys))
+		for _, k := range n.keys {
+			if lo != nil {
+				s.Greater(k, *lo)
+			}
+			if hi != nil {
+				s.Less(k, *hi)
+			}
+		}
+		if n.leaf() {
+			if leafDepth < 0 {
+				leafDepth = depth
+			}
+			s.Equal(leafDepth, depth, "leaves at different depths")
+			return len(n.keys)
+		}
+		s.Len(n.children, len(n.keys)+1)
+		count := len(n.keys)
+		for i, child := range n.children {
+			childLo, childHi := lo, hi
+			if i > 0 {
+				childLo = &n.keys[i-1]
+			}
+			if i < len(n.keys) {
+				childHi = &n.keys[i]
+			}
+			count += check(child, depth+1, childLo, childHi)
+		}
+		return count
+	}
+	count := check(t.root, 0, nil, nil)
+	s.Equal(t.Len(), count)
+	return count
+}
+
+func keys[K any, V any](seq func(func(K, V) bool)) []K {
+	result := []K{}
+	for k := range seq {
+		result = append(result, k)
+	}
+	return result
+}
+
+func (s *BTreeTestSuite) TestInsertGetDelete() {
+	t := NewBTree[int, int](2)
+	for _, k := range []int{10, 20, 5, 6, 12, 30, 7, 17} {
+		s.True(t.Insert(k, k*10))
+	}
+	s.False(t.Insert(6, 66))
+	s.Equal(8, t.Len())
+	s.checkInvariants(t)
+
+	value, ok := t.Get(6)
+	s.True(ok)
+	s.Equal(66, value)
+	_, ok = t.Get(8)
+	s.False(ok)
+	s.True(t.Contains(30))
+
+	s.Equal([]int{5, 6, 7, 10, 12, 17, 20, 30}, keys(t.All()))
+
+	s.True(t.Delete(10))
+	s.False(t.Delete(10))
+	s.False(t.Contains(10))
+	s.checkInvariants(t)
+
+	minKey, _, _ := t.Min()
+	maxKey, maxValue, _ := t.Max()
+	s.Equal(5, minKey)
+	s.Equal(30, maxKey)
+	s.Equal(300, maxValue)
+
+	for _, k := range []int{5, 6, 7, 12, 17, 20, 30} {
+		s.True(t.Delete(k))
+		s.checkInvariants(t)
+	}
+	s.True(t.IsEmpty())
+	s.Equal(0, t.Height())
+	_, _, ok = t.Min()
+	s.False(ok)
+	s.False(t.Delete(1))
+}
+
+func (s *BTreeTestSuite) TestDegreeIsClamped() {
+	t := NewBTree[int, int](0)
+	s.Equal(2, t.degree)
+}
+
+func (s *BTreeTestSuite) TestRandomOperations() {
+	for _, degree := range []int{2, 3, 5, 16} {
+		t := NewBTree[int, int](degree)
+		reference := map[int]int{}
+		rng := rand.New(rand.NewPCG(uint64(degree), 99))
+		for i := 0; i < 3000; i++ {
+			k := rng.IntN(400)
+			if rng.IntN(3) == 0 {
+				_, present := reference[k]
+				s.Equal(present, t.Delete(k))
+				delete(reference, k)
+			} else {
+				_, present := reference[k]
+				s.Equal(!present, t.Insert(k, i))
+				reference[k] = i
+			}
+		}
+		s.checkInvariants(t)
+
+		want := make([]int, 0, len(reference))
+		for k := range reference {
+			want = append(want, k)
+		}
+		slices.Sort(want)
+		s.Equal(want, keys(t.All()))
+		for k, v := range reference {
+			got, ok := t.Get(k)
+			s.True(ok)
+			s.Equal(v, got)
+		}
+	}
+}
+
+func (s *BTreeTestSuite) TestRange() {
+	t := NewBTree[int, string](3)
+	for i := 0; i < 100; i += 2 {
+		t.Insert(i, strings.Repeat("*", i%5))
+	}
+
+	s.Equal([]int{10, 12, 14, 16, 18, 20}, keys(t.Range(10, 20)))
+	s.Equal([]int{12, 14}, keys(t.Range(11, 15)))
+	s.Equal([]int{0, 2}, keys(t.Range(-10, 2)))
+	s.Equal([]int{96, 98}, keys(t.Range(95, 1000)))
+	s.Empty(keys(t.Range(13, 13)))
+	s.Empty(keys(t.Range(20, 10)))
+	s.Empty(keys(NewBTree[int, int](2).Range(0, 10)))
+
+	seen := []int{}
+	for k := range t.Range(40, 90) {
+		seen = append(seen, k)
+		if len(seen) == 3 {
+			break
+		}
+	}
+	s.Equal([]int{40, 42, 44}, seen)
+}
+
+func (s *BTreeTestSuite) TestBulkLoad() {
+	for _, degree := range []int{2, 3, 4, 8} {
+		for _, n := range []int{0, 1, 2, 3, 4, 7, 8, 15, 16, 17, 63, 64, 100, 1000} {
+			entries := make([]Entry[int, int], n)
+			for i := range entries {
+				entries[i] = Entry[int, int]{Key: i * 3, Value: i}
+			}
+			t, err := NewBTreeFromSorted(degree, entries)
+			s.Require().NoError(err)
+			s.Equal(n, s.checkInvariants(t), "degree %d, n %d", degree, n)
+
+			// The loaded tree must stay valid under further edits.
+			t.Insert(1, -1)
+			t.Delete(0)
+			s.checkInvariants(t)
+			if n > 10 {
+				value, ok := t.Get(30)
+				s.True(ok)
+				s.Equal(10, value)
+			}
+		}
+	}
+
+	_, err := NewBTreeFromSorted(2, []Entry[int, int]{{Key: 1}, {Key: 3}, {Key: 3}})
+	s.EqualError(err, "btree: keys not strictly increasing at index 2")
+}
+
+func (s *BTreeTestSuite) TestBulkLoadPacksNodes() {
+	entries := make([]Entry[int, int], 10000)
+	for i := range entries {
+		entries[i] = Entry[int, int]{Key: i}
+	}
+	loaded, err := NewBTreeFromSorted(16, entries)
+	s.Require().NoError(err)
+
+	inserted := NewBTree[int, int](16)
+	for _, e := range entries {
+		inserted.Insert(e.Key, e.Value)
+	}
+	s.LessOrEqual(loaded.Height(), inserted.Height())
+	s.Equal(3, loaded.Height())
+}
+
+func (s *BTreeTestSuite) TestComparator() {
+	t := NewBTreeFunc[string, int](2, func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	t.Insert("Banana", 1)
+	t.Insert("apple", 2)
+	s.False(t.Insert("BANANA", 3))
+
+	value, ok := t.Get("banana")
+	s.True(ok)
+	s.Equal(3, value)
+	s.Equal([]string{"apple", "Banana"}, keys(t.All()))
+
+	_, err := NewBTreeFromSortedFunc(2, strings.Compare, []Entry[string, int]{{Key: "b"}, {Key: "a"}})
+	s.Error(err)
+}
@@ -0,0 +1,94 @@
+package fenwick
+
+import (
+	"fmt"
+
+	"github.com/raj1kshtz/go-structurarium/numeric"
+	"github.com/raj1kshtz/go-structurarium/vector"
+)
+
+// FenwickTree (binary indexed tree) keeps prefix sums of a fixed-length
+// sequence. Point updates and prefix or range sums take O(log n) and use no
+// memory beyond one value per element. It is not safe for concurrent use.
+type FenwickTree[T numeric.Number] struct {
+	// tree is one-based: tree[i] holds the sum of the (i & -i) elements
+	// ending at position i.
+	tree []T
+}
+
+// NewFenwickTree builds a tree over values in O(n); pass make([]T, n) for n
+// zeros.
+func NewFenwickTree[T numeric.Number](values []T) *FenwickTree[T] {
+	tree := make([]T, len(values)+1)
+	copy(tree[1:], values)
+	for i := 1; i < len(tree); i++ {
+		if parent := i + i&-i; parent < len(tree) {
+			tree[parent] += tree[i]
+		}
+	}
+	return &FenwickTree[T]{tree: tree}
+}
+
+// NewFenwickTreeFromVector builds a tree over a snapshot of v; later changes
+// to v are not reflected.
+func NewFenwickTreeFromVector[T numeric.Number](v *vector.WrapperVector[T]) *FenwickTree[T] {
+	return NewFenwickTree(v.ToArray())
+}
+
+func (ft *FenwickTree[T]) Len() int {
+	return len(ft.tree) - 1
+}
+
+// Add adds delta to the element at index.
+func (ft *FenwickTree[T]) Add(index int, delta T) error {
+	if index < 0 || index >= ft.Len() {
+		return fmt.Errorf("index %d out of range [0, %d)", index, ft.Len())
+	}
+	for i := index + 1; i < len(ft.tree); i += i & -i {
+		ft.tree[i] += delta
+	}
+	return nil
+}
+
+func (ft *FenwickTree[T]) prefixSum(n int) T {
+	var sum T
+	for i := n; i > 0; i -= i & -i {
+		sum += ft.tree[i]
+	}
+	return sum
+}
+
+// PrefixSum returns the sum of the first n elements.
+func (ft *FenwickTree[T]) PrefixSum(n int) (T, error) {
+	if n < 0 || n > ft.Len() {
+		var zero T
+		return zero, fmt.Errorf("length %d out of range [0, %d]", n, ft.Len())
+	}
+	return ft.prefixSum(n), nil
+}
+
+// RangeSum returns the sum of the elements in [lo, hi).
+func (ft *FenwickTree[T]) RangeSum(lo, hi int) (T, error) {
+	if lo < 0 || hi > ft.Len() || lo > hi {
+		var zero T
+		return zero, fmt.Errorf("range [%d, %d) out of range [0, %d]", lo, hi, ft.Len())
+	}
+	return ft.prefixSum(hi) - ft.prefixSum(lo), nil
+}
+
+func (ft *FenwickTree[T]) Get(index int) (T, error) {
+	if index < 0 || index >= ft.Len() {
+		var zero T
+		return zero, fmt.Errorf("index %d out of range [0, %d)", index, ft.Len())
+	}
+	return ft.prefixSum(index+1) - ft.prefixSum(index), nil
+}
+
+// Set replaces the element at index.
+func (ft *FenwickTree[T]) Set(index int, value T) error {
+	current, err := ft.Get(index)
+	if err != nil {
+		return err
+	}
+	return ft.Add(index, value-current)
+}
@@ -0,0 +1,104 @@
+package fenwick
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/raj1kshtz/go-structurarium/vector"
+	"github.com/stretchr/testify/suite"
+)
+
+type FenwickTreeTestSuite struct {
+	suite.Suite
+	tree *FenwickTree[int]
+}
+
+func TestFenwickTreeTestSuite(t *testing.T) {
+	suite.Run(t, new(FenwickTreeTestSuite))
+}
+
+func (s *FenwickTreeTestSuite) SetupTest() {
+	s.tree = NewFenwickTree([]int{3, 2, -1, 6, 5, 4, -3})
+}
+
+func (s *FenwickTreeTestSuite) TestPrefixAndRangeSums() {
+	s.Equal(7, s.tree.Len())
+	for n, want := range []int{0, 3, 5, 4, 10, 15, 19, 16} {
+		got, err := s.tree.PrefixSum(n)
+		s.NoError(err)
+		s.Equal(want, got, "prefix %d", n)
+	}
+	sum, err := s.tree.RangeSum(2, 5)
+	s.NoError(err)
+	s.Equal(10, sum)
+	sum, _ = s.tree.RangeSum(4, 4)
+	s.Equal(0, sum)
+}
+
+func (s *FenwickTreeTestSuite) TestUpdates() {
+	s.NoError(s.tree.Add(3, 4))
+	v, err := s.tree.Get(3)
+	s.NoError(err)
+	s.Equal(10, v)
+	s.NoError(s.tree.Set(0, -3))
+	sum, _ := s.tree.PrefixSum(7)
+	s.Equal(14, sum)
+	v, _ = s.tree.Get(0)
+	s.Equal(-3, v)
+}
+
+func (s *FenwickTreeTestSuite) TestBounds() {
+	s.Error(s.tree.Add(7, 1))
+	s.Error(s.tree.Set(-1, 1))
+	_, err := s.tree.Get(7)
+	s.Error(err)
+	_, err = s.tree.PrefixSum(8)
+	s.Error(err)
+	_, err = s.tree.RangeSum(5, 4)
+	s.Error(err)
+
+	empty := NewFenwickTree(make([]int, 0))
+	sum, err := empty.PrefixSum(0)
+	s.NoError(err)
+	s.Equal(0, sum)
+}
+
+func (s *FenwickTreeTestSuite) TestFromVector() {
+	v := vector.NewWrapperVector[uint]()
+	for i := uint(1); i <= 5; i++ {
+		v.Add(i)
+	}
+	tree := NewFenwickTreeFromVector(v)
+	sum, _ := tree.RangeSum(1, 4)
+	s.Equal(uint(9), sum)
+	// Lowering an unsigned element relies on wrap-around and still works.
+	s.NoError(tree.Set(2, 0))
+	sum, _ = tree.RangeSum(1, 4)
+	s.Equal(uint(6), sum)
+}
+
+func (s *FenwickTreeTestSuite) TestRandomOperations() {
+	rng := rand.New(rand.NewPCG(5, 6))
+	reference := make([]int, 50)
+	for i := range reference {
+		reference[i] = rng.IntN(100)
+	}
+	tree := NewFenwickTree(reference)
+	for i := 0; i < 2000; i++ {
+		index := rng.IntN(len(reference))
+		if rng.IntN(2) == 0 {
+			delta := rng.IntN(21) - 10
+			s.NoError(tree.Add(index, delta))
+			reference[index] += delta
+			continue
+		}
+		hi := index + rng.IntN(len(reference)-index+1)
+		want := 0
+		for _, v := range reference[index:hi] {
+			want += v
+		}
+		got, err := tree.RangeSum(index, hi)
+		s.NoError(err)
+		s.Equal(want, got)
+	}
+}
@@ -0,0 +1,57 @@
+package graph
+
+import "fmt"
+
+// ToAdjacencyMatrix returns the vertex order and a matrix where cell [i][j]
+// holds weight(edge) for the edge nodes[i] -> nodes[j] and 0 when there is no
+// edge. Passing nil nodes uses g.Vertices().
+func ToAdjacencyMatrix[N comparable, E any](g ReadOnlyGraph[N, E], nodes []N, weight func(E) float64) ([]N, [][]float64) {
+	if nodes == nil {
+		nodes = g.Vertices()
+	}
+	matrix := make([][]float64, len(nodes))
+	for i, from := range nodes {
+		matrix[i] = make([]float64, len(nodes))
+		for j, to := range nodes {
+			if edge, ok := g.Edge(from, to); ok {
+				matrix[i][j] = weight(edge)
+			}
+		}
+	}
+	return nodes, matrix
+}
+
+// FromAdjacencyMatrix adds nodes to g and an edge for every non-zero cell of
+// matrix. Undirected graphs require a symmetric matrix.
+func FromAdjacencyMatrix[N comparable, E any](g Graph[N, E], nodes []N, matrix [][]float64, edge func(float64) E) error {
+	if len(matrix) != len(nodes) {
+		return fmt.Errorf("graph: matrix has %d rows for %d nodes", len(matrix), len(nodes))
+	}
+	for i, row := range matrix {
+		if len(row) != len(nodes) {
+			return fmt.Errorf("graph: matrix row %d has %d columns for %d nodes", i, len(row), len(nodes))
+		}
+	}
+	for i, row := range matrix {
+		if g.IsDirected() {
+			break
+		}
+		for j := range row {
+			if matrix[i][j] != matrix[j][i] {
+				return fmt.Errorf("graph: matrix is not symmetric at [%d][%d]", i, j)
+			}
+		}
+	}
+	for _, node := range nodes {
+		g.AddVertex(node)
+	}
+	for i, row := range matrix {
+		for j, w := range row {
+			if w == 0 || (!g.IsDirected() && j < i) {
+				continue
+			}
+			g.AddEdge(nodes[i], nodes[j], edge(w))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AdjacencyMatrixTestSuite struct {
+	suite.Suite
+}
+
+func toWeight(w int) float64   { return float64(w) }
+func fromWeight(w float64) int { return int(w) }
+
+func (s *AdjacencyMatrixTestSuite) TestDirectedRoundTrip() {
+	g := NewDirectedGraph[string, int]()
+	g.AddEdge("a", "b", 3)
+	g.AddEdge("b", "c", 4)
+	g.AddEdge("c", "a", 5)
+
+	nodes, matrix := ToAdjacencyMatrix[string, int](g, []string{"a", "b", "c"}, toWeight)
+	s.Equal([]string{"a", "b", "c"}, nodes)
+	s.Equal([][]float64{{0, 3, 0}, {0, 0, 4}, {5, 0, 0}}, matrix)
+
+	decoded := NewDirectedGraph[string, int]()
+	s.NoError(FromAdjacencyMatrix[string, int](decoded, nodes, matrix, fromWeight))
+	s.Len(decoded.Edges(), 3)
+	w, ok := decoded.Edge("c", "a")
+	s.True(ok)
+	s.Equal(5, w)
+	s.False(decoded.HasEdge("a", "c"))
+}
+
+func (s *AdjacencyMatrixTestSuite) TestUndirectedRoundTrip() {
+	g := NewUndirectedGraph[int, int]()
+	g.AddEdge(1, 2, 7)
+	g.AddEdge(2, 3, 8)
+	g.AddVertex(4)
+
+	nodes, matrix := ToAdjacencyMatrix[int, int](g, nil, toWeight)
+	s.Len(nodes, 4)
+
+	decoded := NewUndirectedGraph[int, int]()
+	s.NoError(FromAdjacencyMatrix[int, int](decoded, nodes, matrix, fromWeight))
+	s.ElementsMatch([]int{1, 2, 3, 4}, decoded.Vertices())
+	s.Len(decoded.Edges(), 2)
+	w, _ := decoded.Edge(3, 2)
+	s.Equal(8, w)
+}
+
+func (s *AdjacencyMatrixTestSuite) TestInvalidMatrix() {
+	s.Error(FromAdjacencyMatrix[int, int](NewDirectedGraph[int, int](), []int{1, 2}, [][]float64{{0, 1}}, fromWeight))
+	s.Error(FromAdjacencyMatrix[int, int](NewDirectedGraph[int, int](), []int{1, 2}, [][]float64{{0, 1}, {0}}, fromWeight))
+	s.Error(FromAdjacencyMatrix[int, int](NewUndirectedGraph[int, int](), []int{1, 2}, [][]float64{{0, 1}, {0, 0}}, fromWeight))
+}
+
+func TestAdjacencyMatrixTestSuite(t *testing.T) {
+	suite.Run(t, new(AdjacencyMatrixTestSuite))
+}
@@ -0,0 +1,112 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Attributes holds the key/value pairs written to GraphML <data> elements and
+// GML properties. Values are string, bool, int or float64.
+type Attributes map[string]any
+
+// AttributeMapping converts nodes and edge payloads to and from the
+// attributes stored in GraphML and GML files. Every field is optional except
+// ParseNode, which is needed to read a file back.
+type AttributeMapping[N comparable, E any] struct {
+	NodeID         func(N) string
+	NodeAttributes func(N) Attributes
+	EdgeAttributes func(E) Attributes
+	ParseNode      func(id string, attrs Attributes) (N, error)
+	ParseEdge      func(attrs Attributes) (E, error)
+}
+
+func (m AttributeMapping[N, E]) nodeID(node N) string {
+	if m.NodeID == nil {
+		return fmt.Sprint(node)
+	}
+	return m.NodeID(node)
+}
+
+func (m AttributeMapping[N, E]) nodeAttributes(node N) Attributes {
+	if m.NodeAttributes == nil {
+		return nil
+	}
+	return m.NodeAttributes(node)
+}
+
+func (m AttributeMapping[N, E]) edgeAttributes(edge E) Attributes {
+	if m.EdgeAttributes == nil {
+		return nil
+	}
+	return m.EdgeAttributes(edge)
+}
+
+func (m AttributeMapping[N, E]) parseNode(id string, attrs Attributes) (N, error) {
+	if m.ParseNode == nil {
+		var zero N
+		return zero, fmt.Errorf("graph: AttributeMapping.ParseNode is required for reading")
+	}
+	return m.ParseNode(id, attrs)
+}
+
+func (m AttributeMapping[N, E]) parseEdge(attrs Attributes) (E, error) {
+	if m.ParseEdge == nil {
+		var zero E
+		return zero, nil
+	}
+	return m.ParseEdge(attrs)
+}
+
+// AttributeValue returns the value stored under key if it has type T. Numbers
+// are stored as int or float64.
+func AttributeValue[T any](attrs Attributes, key string) (T, bool) {
+	v, ok := attrs[key].(T)
+	return v, ok
+}
+
+func (a Attributes) sortedKeys() []string {
+	keys := make([]string, 0, len(a))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// normalizeAttribute folds the integer and float kinds a caller may use into
+// int and float64 so that encoders only deal with four types.
+func normalizeAttribute(value any) (any, error) {
+	switch v := value.(type) {
+	case string, bool, int, float64:
+		return v, nil
+	case int8:
+		return int(v), nil
+	case int16:
+		return int(v), nil
+	case int32:
+		return int(v), nil
+	case int64:
+		return int(v), nil
+	case uint8:
+		return int(v), nil
+	case uint16:
+		return int(v), nil
+	case uint32:
+		return int(v), nil
+	case uint:
+		if v > math.MaxInt {
+			return nil, fmt.Errorf("graph: attribute value %d overflows int", v)
+		}
+		return int(v), nil
+	case uint64:
+		if v > math.MaxInt {
+			return nil, fmt.Errorf("graph: attribute value %d overflows int", v)
+		}
+		return int(v), nil
+	case float32:
+		return float64(v), nil
+	default:
+		return nil, fmt.Errorf("graph: unsupported attribute type %T", value)
+	}
+}
@@ -0,0 +1,299 @@
+package graph
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// Benchmarks comparing the adjacency-list, dense and frozen backends on the
+// same random graphs, for every algorithm that accepts a ReadOnlyGraph. Run
+// with: go test -bench Backend -run '^$' ./graph
+
+type benchBackend struct {
+	name  string
+	build func(n int, edges [][2]int) ReadOnlyGraph[int, float64]
+}
+
+var benchBackends = []benchBackend{
+	{"List", func(n int, edges [][2]int) ReadOnlyGraph[int, float64] {
+		g := NewUndirectedGraph[int, float64]()
+		fillBench(g, n, edges)
+		return g
+	}},
+	{"Dense", func(n int, edges [][2]int) ReadOnlyGraph[int, float64] {
+		g := NewDenseUndirectedGraph[int, float64](n)
+		fillBench(g, n, edges)
+		return g
+	}},
+	{"Frozen", func(n int, edges [][2]int) ReadOnlyGraph[int, float64] {
+		g := NewUndirectedGraph[int, float64]()
+		fillBench(g, n, edges)
+		return g.Freeze()
+	}},
+}
+
+func fillBench(g Graph[int, float64], n int, edges [][2]int) {
+	for i := 0; i < n; i++ {
+		g.AddVertex(i)
+	}
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1], float64(1+(e[0]*7+e[1]*13)%10))
+	}
+}
+
+// completeEdges returns every pair of n vertices.
+func completeEdges(n int) [][2]int {
+	edges := [][2]int{}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			edges = append(edges, [2]int{i, j})
+		}
+	}
+	return edges
+}
+
+// randomEdges returns each pair of n vertices with probability p.
+func randomEdges(n int, p float64) [][2]int {
+	rng := rand.New(rand.NewPCG(1, 2))
+	edges := [][2]int{}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if rng.Float64() < p {
+				edges = append(edges, [2]int{i, j})
+			}
+		}
+	}
+	return edges
+}
+
+func BenchmarkBackendHasEdge(b *testing.B) {
+	const n = 500
+	edges := randomEdges(n, 0.5)
+	for _, backend := range benchBackends {
+		g := backend.build(n, edges)
+		b.Run(backend.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				g.HasEdge(i%n, (i*31)%n)
+			}
+		})
+	}
+}
+
+func BenchmarkBackendNeighbors(b *testing.B) {
+	const n = 500
+	edges := randomEdges(n, 0.5)
+	for _, backend := range benchBackends {
+		g := backend.build(n, edges)
+		b.Run(backend.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				g.Neighbors(i % n)
+			}
+		})
+	}
+}
+
+func BenchmarkBackendAllShortestPaths(b *testing.B) {
+	const n = 300
+	edges := randomEdges(n, 0.05)
+	weight := func(w float64) float64 { return w }
+	for _, backend := range benchBackends {
+		g := backend.build(n, edges)
+		b.Run(backend.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = AllShortestPaths(g, 0, n-1, weight)
+			}
+		})
+	}
+}
+
+func BenchmarkBackendKShortestPaths(b *testing.B) {
+	const n = 100
+	edges := randomEdges(n, 0.1)
+	weight := func(w float64) float64 { return w }
+	for _, backend := range benchBackends {
+		g := backend.build(n, edges)
+		b.Run(backend.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = KShortestPaths(g, 0, n-1, 5, weight)
+			}
+		})
+	}
+}
+
+func BenchmarkBackendIsomorphism(b *testing.B) {
+	const n = 60
+	edges := randomEdges(n, 0.3)
+	for _, backend := range benchBackends {
+		g := backend.build(n, edges)
+		b.Run(backend.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				IsIsomorphic(g, g, MatchOptions[int, float64]{})
+			}
+		})
+	}
+}
+
+func BenchmarkBackendAllSimplePaths(b *testing.B) {
+	const n = 60
+	edges := randomEdges(n, 0.1)
+	for _, backend := range benchBackends {
+		g := backend.build(n, edges)
+		b.Run(backend.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for range AllSimplePaths(g, 0, n-1, 4) {
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkBackendEulerianCircuit(b *testing.B) {
+	// Every vertex of a complete graph on an odd number of vertices has even
+	// degree.
+	const n = 101
+	edges := completeEdges(n)
+	for _, backend := range benchBackends {
+		g := backend.build(n, edges)
+		b.Run(backend.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = EulerianCircuit(g)
+			}
+		})
+	}
+}
+
+func BenchmarkBackendHamiltonianPath(b *testing.B) {
+	const n = MaxHamiltonianVertices
+	edges := randomEdges(n, 0.3)
+	for _, backend := range benchBackends {
+		g := backend.build(n, edges)
+		b.Run(backend.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = HamiltonianPath(g)
+			}
+		})
+	}
+}
+
+func BenchmarkBackendTSP(b *testing.B) {
+	const n = 80
+	edges := completeEdges(n)
+	weight := func(w float64) float64 { return w }
+	for _, backend := range benchBackends {
+		g := backend.build(n, edges)
+		b.Run(backend.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				tour, _ := TSPNearestNeighbor(g, 0, weight)
+				_, _ = TSPTwoOpt(g, tour.Nodes, weight)
+			}
+		})
+	}
+}
+
+func BenchmarkBackendToAdjacencyMatrix(b *testing.B) {
+	const n = 300
+	edges := randomEdges(n, 0.2)
+	weight := func(w float64) float64 { return w }
+	for _, backend := range benchBackends {
+		g := backend.build(n, edges)
+		b.Run(backend.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ToAdjacencyMatrix(g, nil, weight)
+			}
+		})
+	}
+}
+
+func BenchmarkBackendColoring(b *testing.B) {
+	const n = 300
+	edges := randomEdges(n, 0.1)
+	for _, backend := range benchBackends {
+		g := backend.build(n, edges)
+		b.Run(backend.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = DSaturColoring(g)
+			}
+		})
+	}
+}
+
+func BenchmarkBackendMaximalCliques(b *testing.B) {
+	const n = 100
+	edges := randomEdges(n, 0.2)
+	for _, backend := range benchBackends {
+		g := backend.build(n, edges)
+		b.Run(backend.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = MaximalCliques(g)
+			}
+		})
+	}
+}
+
+func BenchmarkBackendMaxIndependentSet(b *testing.B) {
+	const n = 300
+	edges := randomEdges(n, 0.1)
+	for _, backend := range benchBackends {
+		g := backend.build(n, edges)
+		b.Run(backend.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = MaxIndependentSet(g)
+			}
+		})
+	}
+}
+
+func BenchmarkBackendCoreNumbers(b *testing.B) {
+	const n = 500
+	edges := randomEdges(n, 0.05)
+	for _, backend := range benchBackends {
+		g := backend.build(n, edges)
+		b.Run(backend.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = CoreNumbers(g)
+			}
+		})
+	}
+}
+
+func BenchmarkBackendLouvain(b *testing.B) {
+	const n = 200
+	edges := randomEdges(n, 0.05)
+	weight := func(w float64) float64 { return w }
+	for _, backend := range benchBackends {
+		g := backend.build(n, edges)
+		b.Run(backend.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _, _ = Louvain(g, weight, 1)
+			}
+		})
+	}
+}
+
+func BenchmarkBackendLabelPropagation(b *testing.B) {
+	const n = 200
+	edges := randomEdges(n, 0.05)
+	weight := func(w float64) float64 { return w }
+	for _, backend := range benchBackends {
+		g := backend.build(n, edges)
+		b.Run(backend.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _, _ = LabelPropagation(g, weight, 1)
+			}
+		})
+	}
+}
+
+func BenchmarkBackendChristofides(b *testing.B) {
+	const n = 40
+	edges := completeEdges(n)
+	weight := func(w float64) float64 { return w }
+	for _, backend := range benchBackends {
+		g := backend.build(n, edges)
+		b.Run(backend.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = TSPChristofides(g, 0, weight)
+			}
+		})
+	}
+}
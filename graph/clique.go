@@ -0,0 +1,152 @@
+package graph
+
+// MaximalCliques lists every maximal clique of g using the Bron–Kerbosch
+// algorithm with pivoting. Isolated vertices form cliques of size one and
+// self-loops are ignored. It fails with ErrDirected on a directed graph.
+func MaximalCliques[N comparable, E any](g ReadOnlyGraph[N, E]) ([][]N, error) {
+	if err := requireUndirected(g, "clique enumeration"); err != nil {
+		return nil, err
+	}
+	ig := newIndexedGraph[N, E](g)
+	cliques := [][]N{}
+	for _, clique := range bronKerbosch(ig) {
+		nodes := make([]N, len(clique))
+		for i, v := range clique {
+			nodes[i] = ig.nodes[v]
+		}
+		cliques = append(cliques, nodes)
+	}
+	return cliques, nil
+}
+
+// MaximumClique returns one largest clique of g. It enumerates all maximal
+// cliques, so it is exponential in the worst case.
+func MaximumClique[N comparable, E any](g ReadOnlyGraph[N, E]) ([]N, error) {
+	cliques, err := MaximalCliques(g)
+	if err != nil {
+		return nil, err
+	}
+	best := []N{}
+	for _, clique := range cliques {
+		if len(clique) > len(best) {
+			best = clique
+		}
+	}
+	return best, nil
+}
+
+// MaxIndependentSet approximates a maximum independent set with the
+// minimum-degree greedy heuristic: keep the vertex with the fewest remaining
+// neighbours, drop those neighbours, and repeat. It fails with ErrDirected on
+// a directed graph.
+func MaxIndependentSet[N comparable, E any](g ReadOnlyGraph[N, E]) ([]N, error) {
+	if err := requireUndirected(g, "independent set"); err != nil {
+		return nil, err
+	}
+	ig := newIndexedGraph[N, E](g)
+	removed := make([]bool, ig.size())
+	remainingDegree := make([]int, ig.size())
+	for v := range remainingDegree {
+		remainingDegree[v] = ig.degree(v)
+	}
+	remove := func(v int) {
+		removed[v] = true
+		for _, u := range ig.adj[v] {
+			if u != v && !removed[u] {
+				remainingDegree[u]--
+			}
+		}
+	}
+	set := []N{}
+	for {
+		pick := -1
+		for v := range removed {
+			if !removed[v] && (pick == -1 || remainingDegree[v] < remainingDegree[pick]) {
+				pick = v
+			}
+		}
+		if pick == -1 {
+			return set, nil
+		}
+		set = append(set, ig.nodes[pick])
+		remove(pick)
+		for _, u := range ig.adj[pick] {
+			if !removed[u] {
+				remove(u)
+			}
+		}
+	}
+}
+
+// IsIndependentSet reports whether no two vertices of set are adjacent. It
+// fails with ErrDirected on a directed graph.
+func IsIndependentSet[N comparable, E any](g ReadOnlyGraph[N, E], set []N) (bool, error) {
+	if err := requireUndirected(g, "independent set check"); err != nil {
+		return false, err
+	}
+	for i, a := range set {
+		for _, b := range set[i+1:] {
+			if g.HasEdge(a, b) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+func bronKerbosch[N comparable](ig *indexedGraph[N]) [][]int {
+	cliques := [][]int{}
+	p := make(map[int]bool, ig.size())
+	for v := 0; v < ig.size(); v++ {
+		p[v] = true
+	}
+	var expand func(r []int, p, x map[int]bool)
+	expand = func(r []int, p, x map[int]bool) {
+		if len(p) == 0 && len(x) == 0 {
+			if len(r) == 0 {
+				return
+			}
+			cliques = append(cliques, append([]int{}, r...))
+			return
+		}
+		pivot, most := -1, -1
+		for _, set := range []map[int]bool{p, x} {
+			for u := range set {
+				count := 0
+				for v := range p {
+					if v != u && ig.hasEdge(u, v) {
+						count++
+					}
+				}
+				if count > most {
+					pivot, most = u, count
+				}
+			}
+		}
+		candidates := []int{}
+		for v := range p {
+			if v == pivot || !ig.hasEdge(pivot, v) {
+				candidates = append(candidates, v)
+			}
+		}
+		for _, v := range candidates {
+			nextP := make(map[int]bool)
+			nextX := make(map[int]bool)
+			for u := range p {
+				if u != v && ig.hasEdge(v, u) {
+					nextP[u] = true
+				}
+			}
+			for u := range x {
+				if u != v && ig.hasEdge(v, u) {
+					nextX[u] = true
+				}
+			}
+			expand(append(r, v), nextP, nextX)
+			delete(p, v)
+			x[v] = true
+		}
+	}
+	expand([]int{}, p, make(map[int]bool))
+	return cliques
+}
@@ -0,0 +1,94 @@
+package graph
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+func sortedCliques(cliques [][]int) [][]int {
+	for _, c := range cliques {
+		sort.Ints(c)
+	}
+	sort.Slice(cliques, func(i, j int) bool {
+		a, b := cliques[i], cliques[j]
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return len(a) < len(b)
+	})
+	return cliques
+}
+
+type CliqueTestSuite struct {
+	suite.Suite
+}
+
+func (s *CliqueTestSuite) independent(g ReadOnlyGraph[int, struct{}], set []int) bool {
+	ok, err := IsIndependentSet(g, set)
+	s.NoError(err)
+	return ok
+}
+
+func (s *CliqueTestSuite) TestMaximalCliques() {
+	g := undirectedFromEdges([][2]int{
+		{1, 2}, {1, 3}, {2, 3}, {2, 4}, {3, 4}, {4, 5}, {5, 6}, {4, 6},
+	})
+	g.AddVertex(7)
+	cliques, err := MaximalCliques(g)
+	s.NoError(err)
+	s.Equal([][]int{{1, 2, 3}, {2, 3, 4}, {4, 5, 6}, {7}}, sortedCliques(cliques))
+
+	cliques, err = MaximalCliques(NewUndirectedGraph[int, struct{}]())
+	s.NoError(err)
+	s.Empty(cliques)
+}
+
+func (s *CliqueTestSuite) TestMaximumClique() {
+	g := undirectedFromEdges([][2]int{
+		{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}, {3, 4}, {4, 5},
+	})
+	clique, err := MaximumClique(g)
+	s.NoError(err)
+	sort.Ints(clique)
+	s.Equal([]int{0, 1, 2, 3}, clique)
+}
+
+func (s *CliqueTestSuite) TestMaxIndependentSet() {
+	g := undirectedFromEdges(petersenEdges())
+	set, err := MaxIndependentSet(g)
+	s.NoError(err)
+	s.True(s.independent(g, set))
+	s.GreaterOrEqual(len(set), 3)
+
+	star := undirectedFromEdges([][2]int{{0, 1}, {0, 2}, {0, 3}, {0, 4}})
+	leaves, err := MaxIndependentSet(star)
+	s.NoError(err)
+	s.ElementsMatch([]int{1, 2, 3, 4}, leaves)
+
+	path := undirectedFromEdges([][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}})
+	set, err = MaxIndependentSet(path)
+	s.NoError(err)
+	s.Len(set, 3)
+	s.False(s.independent(path, []int{1, 2}))
+}
+
+func (s *CliqueTestSuite) TestDirectedGraphsAreRejected() {
+	g := NewDirectedGraph[int, struct{}]()
+	g.AddEdge(0, 1, struct{}{})
+	_, err := MaximalCliques(g)
+	s.ErrorIs(err, ErrDirected)
+	_, err = MaximumClique(g)
+	s.ErrorIs(err, ErrDirected)
+	_, err = MaxIndependentSet(g)
+	s.ErrorIs(err, ErrDirected)
+	_, err = IsIndependentSet(g, []int{0})
+	s.ErrorIs(err, ErrDirected)
+}
+
+func TestCliqueTestSuite(t *testing.T) {
+	suite.Run(t, new(CliqueTestSuite))
+}
@@ -0,0 +1,212 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+)
+
+// MaxExactColoringVertices bounds the graphs ChromaticNumber will attempt,
+// since exact coloring takes exponential time.
+const MaxExactColoringVertices = 64
+
+// GreedyColoring gives each vertex, in the given order, the smallest color not
+// used by an already colored neighbour. A nil order colors vertices by
+// decreasing degree. Colors start at 0 and self-loops are ignored. It fails
+// with ErrDirected on a directed graph.
+func GreedyColoring[N comparable, E any](g ReadOnlyGraph[N, E], order []N) (map[N]int, error) {
+	if err := requireUndirected(g, "greedy coloring"); err != nil {
+		return nil, err
+	}
+	ig := newIndexedGraph[N, E](g)
+	var seq []int
+	if order == nil {
+		seq = verticesByDegree(ig)
+	} else {
+		for _, node := range order {
+			if i, ok := ig.index[node]; ok {
+				seq = append(seq, i)
+			}
+		}
+	}
+	colors := make([]int, ig.size())
+	for i := range colors {
+		colors[i] = -1
+	}
+	for _, v := range seq {
+		colors[v] = smallestFreeColor(ig, colors, v)
+	}
+	return colorMap(ig, colors), nil
+}
+
+// DSaturColoring repeatedly colors the vertex whose neighbours already use the
+// most distinct colors, breaking ties by degree. It is optimal on bipartite
+// graphs, cycles and wheels and usually beats plain greedy coloring. It fails
+// with ErrDirected on a directed graph.
+func DSaturColoring[N comparable, E any](g ReadOnlyGraph[N, E]) (map[N]int, error) {
+	if err := requireUndirected(g, "DSatur coloring"); err != nil {
+		return nil, err
+	}
+	ig := newIndexedGraph[N, E](g)
+	return colorMap(ig, dsatur(ig)), nil
+}
+
+// ChromaticNumber returns the minimum number of colors needed for g along with
+// a coloring that uses them. It refuses directed graphs and graphs with more
+// than MaxExactColoringVertices vertices.
+func ChromaticNumber[N comparable, E any](g ReadOnlyGraph[N, E]) (int, map[N]int, error) {
+	if err := requireUndirected(g, "exact coloring"); err != nil {
+		return 0, nil, err
+	}
+	ig := newIndexedGraph[N, E](g)
+	if ig.size() > MaxExactColoringVertices {
+		return 0, nil, fmt.Errorf("graph: exact coloring supports at most %d vertices, got %d", MaxExactColoringVertices, ig.size())
+	}
+	best := dsatur(ig)
+	upper := colorCount(best)
+	lower := 0
+	for _, clique := range bronKerbosch(ig) {
+		if len(clique) > lower {
+			lower = len(clique)
+		}
+	}
+	order := verticesByDegree(ig)
+	for k := lower; k < upper; k++ {
+		colors := make([]int, ig.size())
+		for i := range colors {
+			colors[i] = -1
+		}
+		if kColor(ig, order, colors, 0, k, 0) {
+			return k, colorMap(ig, colors), nil
+		}
+	}
+	return upper, colorMap(ig, best), nil
+}
+
+// IsProperColoring reports whether every vertex has a color and no edge joins
+// two vertices of the same color. Self-loops are ignored. It fails with
+// ErrDirected on a directed graph.
+func IsProperColoring[N comparable, E any](g ReadOnlyGraph[N, E], colors map[N]int) (bool, error) {
+	if err := requireUndirected(g, "coloring check"); err != nil {
+		return false, err
+	}
+	for _, v := range g.Vertices() {
+		c, ok := colors[v]
+		if !ok {
+			return false, nil
+		}
+		for _, u := range g.Neighbors(v) {
+			if u != v && colors[u] == c {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+func dsatur[N comparable](ig *indexedGraph[N]) []int {
+	n := ig.size()
+	colors := make([]int, n)
+	for i := range colors {
+		colors[i] = -1
+	}
+	saturation := make([]map[int]bool, n)
+	for i := range saturation {
+		saturation[i] = make(map[int]bool)
+	}
+	for colored := 0; colored < n; colored++ {
+		pick := -1
+		for v := 0; v < n; v++ {
+			if colors[v] != -1 {
+				continue
+			}
+			if pick == -1 || len(saturation[v]) > len(saturation[pick]) ||
+				(len(saturation[v]) == len(saturation[pick]) && ig.degree(v) > ig.degree(pick)) {
+				pick = v
+			}
+		}
+		colors[pick] = smallestFreeColor(ig, colors, pick)
+		for _, u := range ig.adj[pick] {
+			saturation[u][colors[pick]] = true
+		}
+	}
+	return colors
+}
+
+// kColor tries to extend colors to every vertex in order using at most k
+// colors. A vertex may only open color used+1, which skips colorings that
+// differ by a permutation of colors.
+func kColor[N comparable](ig *indexedGraph[N], order []int, colors []int, pos, k, used int) bool {
+	if pos == len(order) {
+		return true
+	}
+	v := order[pos]
+	limit := used + 1
+	if limit > k {
+		limit = k
+	}
+	for c := 0; c < limit; c++ {
+		conflict := false
+		for _, u := range ig.adj[v] {
+			if u != v && colors[u] == c {
+				conflict = true
+				break
+			}
+		}
+		if conflict {
+			continue
+		}
+		colors[v] = c
+		next := used
+		if c == used {
+			next++
+		}
+		if kColor(ig, order, colors, pos+1, k, next) {
+			return true
+		}
+	}
+	colors[v] = -1
+	return false
+}
+
+func smallestFreeColor[N comparable](ig *indexedGraph[N], colors []int, v int) int {
+	taken := make(map[int]bool)
+	for _, u := range ig.adj[v] {
+		if u != v && colors[u] >= 0 {
+			taken[colors[u]] = true
+		}
+	}
+	c := 0
+	for taken[c] {
+		c++
+	}
+	return c
+}
+
+func colorMap[N comparable](ig *indexedGraph[N], colors []int) map[N]int {
+	m := make(map[N]int, len(colors))
+	for i, c := range colors {
+		if c >= 0 {
+			m[ig.nodes[i]] = c
+		}
+	}
+	return m
+}
+
+func colorCount(colors []int) int {
+	count := 0
+	for _, c := range colors {
+		if c+1 > count {
+			count = c + 1
+		}
+	}
+	return count
+}
+
+func verticesByDegree[N comparable](ig *indexedGraph[N]) []int {
+	seq := make([]int, ig.size())
+	for i := range seq {
+		seq[i] = i
+	}
+	sort.SliceStable(seq, func(a, b int) bool { return ig.degree(seq[a]) > ig.degree(seq[b]) })
+	return seq
+}
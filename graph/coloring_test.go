@@ -0,0 +1,153 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+func undirectedFromEdges(edges [][2]int) *UndirectedGraph[int, struct{}] {
+	g := NewUndirectedGraph[int, struct{}]()
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1], struct{}{})
+	}
+	return g
+}
+
+func cycleEdges(n int) [][2]int {
+	edges := [][2]int{}
+	for i := 0; i < n; i++ {
+		edges = append(edges, [2]int{i, (i + 1) % n})
+	}
+	return edges
+}
+
+func petersenEdges() [][2]int {
+	edges := [][2]int{}
+	for i := 0; i < 5; i++ {
+		edges = append(edges, [2]int{i, (i + 1) % 5}, [2]int{i, i + 5}, [2]int{i + 5, (i+2)%5 + 5})
+	}
+	return edges
+}
+
+func distinctColors(colors map[int]int) int {
+	seen := map[int]bool{}
+	for _, c := range colors {
+		seen[c] = true
+	}
+	return len(seen)
+}
+
+type ColoringTestSuite struct {
+	suite.Suite
+}
+
+func (s *ColoringTestSuite) isProper(g ReadOnlyGraph[int, struct{}], colors map[int]int) bool {
+	ok, err := IsProperColoring(g, colors)
+	s.NoError(err)
+	return ok
+}
+
+func (s *ColoringTestSuite) TestGreedyColoring() {
+	g := undirectedFromEdges(petersenEdges())
+	colors, err := GreedyColoring(g, nil)
+	s.NoError(err)
+	s.Len(colors, 10)
+	s.True(s.isProper(g, colors))
+
+	// Crown graph: alternating order forces greedy into one color per pair.
+	crown := NewUndirectedGraph[int, struct{}]()
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if i != j {
+				crown.AddEdge(i, 10+j, struct{}{})
+			}
+		}
+	}
+	bad, err := GreedyColoring(crown, []int{0, 10, 1, 11, 2, 12, 3, 13})
+	s.NoError(err)
+	s.True(s.isProper(crown, bad))
+	s.Equal(4, distinctColors(bad))
+	good, err := DSaturColoring(crown)
+	s.NoError(err)
+	s.Equal(2, distinctColors(good))
+}
+
+func (s *ColoringTestSuite) TestDSaturColoring() {
+	even := undirectedFromEdges(cycleEdges(8))
+	colors, err := DSaturColoring(even)
+	s.NoError(err)
+	s.True(s.isProper(even, colors))
+	s.Equal(2, distinctColors(colors))
+
+	odd := undirectedFromEdges(cycleEdges(7))
+	colors, err = DSaturColoring(odd)
+	s.NoError(err)
+	s.True(s.isProper(odd, colors))
+	s.Equal(3, distinctColors(colors))
+}
+
+func (s *ColoringTestSuite) TestChromaticNumber() {
+	cases := []struct {
+		name  string
+		edges [][2]int
+		want  int
+	}{
+		{"EvenCycle", cycleEdges(6), 2},
+		{"OddCycle", cycleEdges(5), 3},
+		{"Petersen", petersenEdges(), 3},
+		{"K5", [][2]int{{0, 1}, {0, 2}, {0, 3}, {0, 4}, {1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}, 5},
+		// Grötzsch graph: triangle-free yet needs 4 colors.
+		{"Grotzsch", [][2]int{
+			{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 0},
+			{5, 1}, {5, 4}, {6, 0}, {6, 2}, {7, 1}, {7, 3}, {8, 2}, {8, 4}, {9, 3}, {9, 0},
+			{10, 5}, {10, 6}, {10, 7}, {10, 8}, {10, 9},
+		}, 4},
+	}
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			g := undirectedFromEdges(tc.edges)
+			k, colors, err := ChromaticNumber(g)
+			s.NoError(err)
+			s.Equal(tc.want, k)
+			s.True(s.isProper(g, colors))
+			s.Equal(tc.want, distinctColors(colors))
+		})
+	}
+
+	k, colors, err := ChromaticNumber(NewUndirectedGraph[int, struct{}]())
+	s.NoError(err)
+	s.Equal(0, k)
+	s.Empty(colors)
+
+	big := undirectedFromEdges(cycleEdges(MaxExactColoringVertices + 1))
+	_, _, err = ChromaticNumber(big)
+	s.Error(err)
+}
+
+func (s *ColoringTestSuite) TestSelfLoopsIgnored() {
+	g := undirectedFromEdges([][2]int{{0, 0}, {0, 1}})
+	colors, err := DSaturColoring(g)
+	s.NoError(err)
+	s.True(s.isProper(g, colors))
+	k, _, err := ChromaticNumber(g)
+	s.NoError(err)
+	s.Equal(2, k)
+}
+
+func (s *ColoringTestSuite) TestDirectedGraphsAreRejected() {
+	g := NewDirectedGraph[int, struct{}]()
+	g.AddEdge(0, 1, struct{}{})
+	_, err := GreedyColoring(g, nil)
+	s.ErrorIs(err, ErrDirected)
+	_, err = DSaturColoring(g)
+	s.ErrorIs(err, ErrDirected)
+	_, _, err = ChromaticNumber(g)
+	s.ErrorIs(err, ErrDirected)
+	_, err = IsProperColoring(g, map[int]int{0: 0, 1: 1})
+	s.ErrorIs(err, ErrDirected)
+}
+
+func TestColoringTestSuite(t *testing.T) {
+	suite.Run(t, new(ColoringTestSuite))
+}
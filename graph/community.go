@@ -0,0 +1,271 @@
+package graph
+
+import (
+	"math/rand/v2"
+	"slices"
+)
+
+// maxLabelPropagationRounds stops label propagation on graphs where labels
+// keep oscillating.
+const maxLabelPropagationRounds = 100
+
+// weightedAdjacency is a symmetric weighted adjacency matrix stored sparsely.
+// A self-loop of weight w is stored as 2w so that a vertex's degree is always
+// the sum of its row.
+type weightedAdjacency []map[int]float64
+
+func newWeightedAdjacency[N comparable, E any](g ReadOnlyGraph[N, E], ig *indexedGraph[N], weight func(E) float64) weightedAdjacency {
+	a := make(weightedAdjacency, ig.size())
+	for i := range a {
+		a[i] = make(map[int]float64)
+		for _, j := range ig.adj[i] {
+			w := 1.0
+			if weight != nil {
+				edge, _ := g.Edge(ig.nodes[i], ig.nodes[j])
+				w = weight(edge)
+			}
+			if i == j {
+				w *= 2
+			}
+			a[i][j] = w
+		}
+	}
+	return a
+}
+
+func (a weightedAdjacency) degrees() ([]float64, float64) {
+	k := make([]float64, len(a))
+	total := 0.0
+	for i, row := range a {
+		for _, w := range row {
+			k[i] += w
+		}
+		total += k[i]
+	}
+	return k, total
+}
+
+func (a weightedAdjacency) modularity(community []int) float64 {
+	k, twoM := a.degrees()
+	if twoM == 0 {
+		return 0
+	}
+	internal := make(map[int]float64)
+	tot := make(map[int]float64)
+	for i, row := range a {
+		tot[community[i]] += k[i]
+		for j, w := range row {
+			if community[i] == community[j] {
+				internal[community[i]] += w
+			}
+		}
+	}
+	q := 0.0
+	for c, t := range tot {
+		q += internal[c]/twoM - (t/twoM)*(t/twoM)
+	}
+	return q
+}
+
+// Modularity scores how much denser the edges inside communities are than in
+// a random graph with the same degrees. A nil weight counts every edge as 1.
+// Vertices missing from communities are treated as singletons. It fails with
+// ErrDirected on a directed graph.
+func Modularity[N comparable, E any](g ReadOnlyGraph[N, E], communities map[N]int, weight func(E) float64) (float64, error) {
+	if err := requireUndirected(g, "modularity"); err != nil {
+		return 0, err
+	}
+	ig := newSortedIndexedGraph[N, E](g)
+	community := make([]int, ig.size())
+	next := -1
+	for _, c := range communities {
+		if c > next {
+			next = c
+		}
+	}
+	for i, node := range ig.nodes {
+		c, ok := communities[node]
+		if !ok {
+			next++
+			c = next
+		}
+		community[i] = c
+	}
+	return newWeightedAdjacency(g, ig, weight).modularity(community), nil
+}
+
+// Louvain finds communities by greedily moving vertices to the neighbouring
+// community with the best modularity gain and then collapsing each community
+// into a single vertex, until nothing moves. A nil weight counts every edge as
+// 1. The same seed always gives the same result for the same graph. It fails
+// with ErrDirected on a directed graph.
+func Louvain[N comparable, E any](g ReadOnlyGraph[N, E], weight func(E) float64, seed uint64) (map[N]int, float64, error) {
+	if err := requireUndirected(g, "Louvain"); err != nil {
+		return nil, 0, err
+	}
+	ig := newSortedIndexedGraph[N, E](g)
+	rng := rand.New(rand.NewPCG(seed, seed))
+	a := newWeightedAdjacency(g, ig, weight)
+	membership := make([]int, ig.size())
+	for i := range membership {
+		membership[i] = i
+	}
+	for {
+		community, moved := louvainLocalMoves(a, rng)
+		if !moved {
+			break
+		}
+		community = renumber(community)
+		for i := range membership {
+			membership[i] = community[membership[i]]
+		}
+		a = aggregate(a, community)
+	}
+	membership = renumber(membership)
+	return communityMap(ig, membership), newWeightedAdjacency(g, ig, weight).modularity(membership), nil
+}
+
+func louvainLocalMoves(a weightedAdjacency, rng *rand.Rand) ([]int, bool) {
+	n := len(a)
+	k, twoM := a.degrees()
+	community := make([]int, n)
+	tot := make([]float64, n)
+	for i := range community {
+		community[i] = i
+		tot[i] = k[i]
+	}
+	if twoM == 0 {
+		return community, false
+	}
+	moved := false
+	for improved := true; improved; {
+		improved = false
+		for _, i := range rng.Perm(n) {
+			own := community[i]
+			links := make(map[int]float64)
+			for j, w := range a[i] {
+				if j != i {
+					links[community[j]] += w
+				}
+			}
+			tot[own] -= k[i]
+			best, bestGain := own, links[own]-tot[own]*k[i]/twoM
+			candidates := make([]int, 0, len(links))
+			for c := range links {
+				candidates = append(candidates, c)
+			}
+			slices.Sort(candidates)
+			for _, c := range candidates {
+				if gain := links[c] - tot[c]*k[i]/twoM; gain > bestGain+1e-12 {
+					best, bestGain = c, gain
+				}
+			}
+			tot[best] += k[i]
+			if best != own {
+				community[i] = best
+				improved = true
+				moved = true
+			}
+		}
+	}
+	return community, moved
+}
+
+// aggregate collapses each community of a into a single vertex.
+func aggregate(a weightedAdjacency, community []int) weightedAdjacency {
+	size := 0
+	for _, c := range community {
+		if c+1 > size {
+			size = c + 1
+		}
+	}
+	next := make(weightedAdjacency, size)
+	for c := range next {
+		next[c] = make(map[int]float64)
+	}
+	for i, row := range a {
+		for j, w := range row {
+			next[community[i]][community[j]] += w
+		}
+	}
+	return next
+}
+
+// LabelPropagation starts with every vertex in its own community and lets
+// vertices, in a seeded random order, adopt the label carrying the most edge
+// weight among their neighbours until every vertex agrees with its
+// neighbourhood. Ties are broken with the same seeded generator. It fails with
+// ErrDirected on a directed graph.
+func LabelPropagation[N comparable, E any](g ReadOnlyGraph[N, E], weight func(E) float64, seed uint64) (map[N]int, float64, error) {
+	if err := requireUndirected(g, "label propagation"); err != nil {
+		return nil, 0, err
+	}
+	ig := newSortedIndexedGraph[N, E](g)
+	rng := rand.New(rand.NewPCG(seed, seed))
+	a := newWeightedAdjacency(g, ig, weight)
+	n := ig.size()
+	labels := make([]int, n)
+	for i := range labels {
+		labels[i] = i
+	}
+	dominant := func(i int) []int {
+		votes := make(map[int]float64)
+		for j, w := range a[i] {
+			if j != i {
+				votes[labels[j]] += w
+			}
+		}
+		best := []int{}
+		most := 0.0
+		for label, v := range votes {
+			if v > most+1e-12 {
+				best, most = []int{label}, v
+			} else if v > most-1e-12 {
+				best = append(best, label)
+			}
+		}
+		slices.Sort(best)
+		return best
+	}
+	for round := 0; round < maxLabelPropagationRounds; round++ {
+		for _, i := range rng.Perm(n) {
+			if best := dominant(i); len(best) > 0 && !slices.Contains(best, labels[i]) {
+				labels[i] = best[rng.IntN(len(best))]
+			}
+		}
+		stable := true
+		for i := 0; i < n && stable; i++ {
+			if best := dominant(i); len(best) > 0 && !slices.Contains(best, labels[i]) {
+				stable = false
+			}
+		}
+		if stable {
+			break
+		}
+	}
+	labels = renumber(labels)
+	return communityMap(ig, labels), a.modularity(labels), nil
+}
+
+// renumber relabels communities as 0, 1, 2, ... in order of first appearance.
+func renumber(community []int) []int {
+	ids := make(map[int]int)
+	out := make([]int, len(community))
+	for i, c := range community {
+		id, ok := ids[c]
+		if !ok {
+			id = len(ids)
+			ids[c] = id
+		}
+		out[i] = id
+	}
+	return out
+}
+
+func communityMap[N comparable](ig *indexedGraph[N], community []int) map[N]int {
+	m := make(map[N]int, len(community))
+	for i, c := range community {
+		m[ig.nodes[i]] = c
+	}
+	return m
+}
@@ -0,0 +1,126 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// ringOfCliques builds count cliques of the given size, linking vertex 0 of
+// each clique to vertex 1 of the next.
+func ringOfCliques(count, size int) *UndirectedGraph[int, struct{}] {
+	g := NewUndirectedGraph[int, struct{}]()
+	for c := 0; c < count; c++ {
+		base := c * size
+		for i := 0; i < size; i++ {
+			for j := i + 1; j < size; j++ {
+				g.AddEdge(base+i, base+j, struct{}{})
+			}
+		}
+		g.AddEdge(base, ((c+1)%count)*size+1, struct{}{})
+	}
+	return g
+}
+
+type CommunityTestSuite struct {
+	suite.Suite
+}
+
+func (s *CommunityTestSuite) modularity(g ReadOnlyGraph[int, struct{}], communities map[int]int) float64 {
+	q, err := Modularity(g, communities, nil)
+	s.NoError(err)
+	return q
+}
+
+func (s *CommunityTestSuite) sameCommunityPerClique(communities map[int]int, count, size int) {
+	seen := map[int]bool{}
+	for c := 0; c < count; c++ {
+		label := communities[c*size]
+		s.False(seen[label], "clique %d shares a community", c)
+		seen[label] = true
+		for i := 1; i < size; i++ {
+			s.Equal(label, communities[c*size+i])
+		}
+	}
+}
+
+func (s *CommunityTestSuite) TestModularity() {
+	g := ringOfCliques(4, 5)
+	all := map[int]int{}
+	perClique := map[int]int{}
+	for v := 0; v < 20; v++ {
+		all[v] = 0
+		perClique[v] = v / 5
+	}
+	s.InDelta(0, s.modularity(g, all), 1e-12)
+	// 44 edges: each clique holds 10 internal edges and a degree sum of 22.
+	want := 4 * (10.0/44 - (22.0/88)*(22.0/88))
+	s.InDelta(want, s.modularity(g, perClique), 1e-12)
+	s.Equal(0.0, s.modularity(NewUndirectedGraph[int, struct{}](), map[int]int{}))
+}
+
+func (s *CommunityTestSuite) TestLouvain() {
+	g := ringOfCliques(4, 5)
+	communities, q, err := Louvain(g, nil, 1)
+	s.NoError(err)
+	s.Len(communities, 20)
+	s.sameCommunityPerClique(communities, 4, 5)
+	s.InDelta(s.modularity(g, communities), q, 1e-12)
+	s.Greater(q, 0.6)
+
+	again, q2, err := Louvain(g, nil, 1)
+	s.NoError(err)
+	s.Equal(communities, again)
+	s.Equal(q, q2)
+}
+
+func (s *CommunityTestSuite) TestLouvainWeighted() {
+	// A square whose heavy edges pair 0-1 and 2-3.
+	g := NewUndirectedGraph[int, float64]()
+	g.AddEdge(0, 1, 10)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 10)
+	g.AddEdge(3, 0, 1)
+	communities, q, err := Louvain(g, func(w float64) float64 { return w }, 7)
+	s.NoError(err)
+	s.Equal(communities[0], communities[1])
+	s.Equal(communities[2], communities[3])
+	s.NotEqual(communities[0], communities[2])
+	s.Greater(q, 0.3)
+}
+
+func (s *CommunityTestSuite) TestLabelPropagation() {
+	g := ringOfCliques(3, 6)
+	communities, q, err := LabelPropagation(g, nil, 42)
+	s.NoError(err)
+	s.Len(communities, 18)
+	s.sameCommunityPerClique(communities, 3, 6)
+	s.InDelta(s.modularity(g, communities), q, 1e-12)
+
+	again, _, err := LabelPropagation(g, nil, 42)
+	s.NoError(err)
+	s.Equal(communities, again)
+
+	isolated := NewUndirectedGraph[string, struct{}]()
+	isolated.AddVertex("a")
+	isolated.AddVertex("b")
+	labels, q, err := LabelPropagation(isolated, nil, 1)
+	s.NoError(err)
+	s.NotEqual(labels["a"], labels["b"])
+	s.Equal(0.0, q)
+}
+
+func (s *CommunityTestSuite) TestDirectedGraphsAreRejected() {
+	g := NewDirectedGraph[int, struct{}]()
+	g.AddEdge(0, 1, struct{}{})
+	_, err := Modularity(g, map[int]int{}, nil)
+	s.ErrorIs(err, ErrDirected)
+	_, _, err = Louvain(g, nil, 1)
+	s.ErrorIs(err, ErrDirected)
+	_, _, err = LabelPropagation(g, nil, 1)
+	s.ErrorIs(err, ErrDirected)
+}
+
+func TestCommunityTestSuite(t *testing.T) {
+	suite.Run(t, new(CommunityTestSuite))
+}
@@ -0,0 +1,187 @@
+package graph
+
+// connectivityIndex labels every vertex with its connected component. Adding
+// an edge relabels the smaller of the two components it joins; removing one
+// searches from both endpoints at once and stops as soon as the searches meet
+// or the smaller side is exhausted, so updates cost time proportional to the
+// smaller component rather than the whole graph.
+type connectivityIndex[N comparable] struct {
+	component map[N]int
+	members   map[int]map[N]bool
+	nextID    int
+}
+
+func newConnectivityIndex[N comparable, E any](g *genericAdjacencyListGraph[N, E]) *connectivityIndex[N] {
+	c := &connectivityIndex[N]{
+		component: make(map[N]int),
+		members:   make(map[int]map[N]bool),
+	}
+	for start := range g.adj {
+		if _, ok := c.component[start]; ok {
+			continue
+		}
+		id := c.newComponent()
+		c.assign(start, id)
+		queue := []N{start}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			for w := range g.adj[v] {
+				if _, ok := c.component[w]; !ok {
+					c.assign(w, id)
+					queue = append(queue, w)
+				}
+			}
+		}
+	}
+	return c
+}
+
+func (c *connectivityIndex[N]) newComponent() int {
+	c.nextID++
+	c.members[c.nextID] = make(map[N]bool)
+	return c.nextID
+}
+
+func (c *connectivityIndex[N]) assign(v N, id int) {
+	if old, ok := c.component[v]; ok {
+		delete(c.members[old], v)
+		if len(c.members[old]) == 0 {
+			delete(c.members, old)
+		}
+	}
+	c.component[v] = id
+	c.members[id][v] = true
+}
+
+func (c *connectivityIndex[N]) vertexAdded(v N) {
+	if _, ok := c.component[v]; !ok {
+		c.assign(v, c.newComponent())
+	}
+}
+
+func (c *connectivityIndex[N]) vertexRemoved(v N) {
+	id, ok := c.component[v]
+	if !ok {
+		return
+	}
+	delete(c.members[id], v)
+	if len(c.members[id]) == 0 {
+		delete(c.members, id)
+	}
+	delete(c.component, v)
+}
+
+func (c *connectivityIndex[N]) edgeAdded(a, b N) {
+	c.vertexAdded(a)
+	c.vertexAdded(b)
+	ca, cb := c.component[a], c.component[b]
+	if ca == cb {
+		return
+	}
+	if len(c.members[ca]) < len(c.members[cb]) {
+		ca, cb = cb, ca
+	}
+	for v := range c.members[cb] {
+		c.assign(v, ca)
+	}
+}
+
+// splitAfterEdgeRemoval must be called after the edge is gone from g.
+func splitAfterEdgeRemoval[N comparable, E any](c *connectivityIndex[N], g *genericAdjacencyListGraph[N, E], a, b N) {
+	if a == b || !g.hasVertex(a) || !g.hasVertex(b) {
+		return
+	}
+	seenA, seenB := map[N]bool{a: true}, map[N]bool{b: true}
+	queueA, queueB := []N{a}, []N{b}
+	// expand visits one vertex of a search and reports whether it reached the
+	// other side.
+	expand := func(queue *[]N, seen, other map[N]bool) bool {
+		v := (*queue)[0]
+		*queue = (*queue)[1:]
+		for w := range g.adj[v] {
+			if other[w] {
+				return true
+			}
+			if !seen[w] {
+				seen[w] = true
+				*queue = append(*queue, w)
+			}
+		}
+		return false
+	}
+	for {
+		if len(queueA) == 0 {
+			c.split(seenA)
+			return
+		}
+		if len(queueB) == 0 {
+			c.split(seenB)
+			return
+		}
+		if expand(&queueA, seenA, seenB) || expand(&queueB, seenB, seenA) {
+			return
+		}
+	}
+}
+
+func (c *connectivityIndex[N]) split(side map[N]bool) {
+	id := c.newComponent()
+	for v := range side {
+		c.assign(v, id)
+	}
+}
+
+// EnableConnectivityIndex starts tracking connected components so that
+// Connected and ComponentCount answer without traversing the graph. The index
+// is built once and then kept up to date by AddVertex, AddEdge, RemoveEdge and
+// RemoveVertex, which become slightly more expensive.
+func (ug *UndirectedGraph[N, E]) EnableConnectivityIndex() {
+	if ug.conn == nil {
+		ug.conn = newConnectivityIndex(ug.g)
+	}
+}
+
+func (ug *UndirectedGraph[N, E]) DisableConnectivityIndex() {
+	ug.conn = nil
+}
+
+func (ug *UndirectedGraph[N, E]) HasConnectivityIndex() bool {
+	return ug.conn != nil
+}
+
+// Connected reports whether a path joins a and b. Without a connectivity index
+// it searches the graph.
+func (ug *UndirectedGraph[N, E]) Connected(a, b N) bool {
+	if !ug.g.hasVertex(a) || !ug.g.hasVertex(b) {
+		return false
+	}
+	if ug.conn != nil {
+		return ug.conn.component[a] == ug.conn.component[b]
+	}
+	seen := map[N]bool{a: true}
+	queue := []N{a}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		if v == b {
+			return true
+		}
+		for w := range ug.g.adj[v] {
+			if !seen[w] {
+				seen[w] = true
+				queue = append(queue, w)
+			}
+		}
+	}
+	return false
+}
+
+// ComponentCount returns the number of connected components. Without a
+// connectivity index it labels the whole graph.
+func (ug *UndirectedGraph[N, E]) ComponentCount() int {
+	if ug.conn != nil {
+		return len(ug.conn.members)
+	}
+	return len(newConnectivityIndex(ug.g).members)
+}
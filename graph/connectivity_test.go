@@ -0,0 +1,126 @@
+package graph
+
+import (
+	"encoding/json"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ConnectivityTestSuite struct {
+	suite.Suite
+	g *UndirectedGraph[int, struct{}]
+}
+
+func (s *ConnectivityTestSuite) SetupTest() {
+	// Two triangles joined by the bridge 2-3, plus the isolated vertex 6.
+	s.g = undirectedFromEdges([][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 5}, {5, 3}})
+	s.g.AddVertex(6)
+}
+
+func (s *ConnectivityTestSuite) TestWithoutIndex() {
+	s.False(s.g.HasConnectivityIndex())
+	s.True(s.g.Connected(0, 5))
+	s.False(s.g.Connected(0, 6))
+	s.False(s.g.Connected(0, 99))
+	s.Equal(2, s.g.ComponentCount())
+}
+
+func (s *ConnectivityTestSuite) TestIndexTracksChanges() {
+	s.g.EnableConnectivityIndex()
+	s.True(s.g.HasConnectivityIndex())
+	s.Equal(2, s.g.ComponentCount())
+	s.True(s.g.Connected(0, 5))
+
+	s.Run("removing a cycle edge keeps components", func() {
+		s.g.RemoveEdge(0, 1)
+		s.True(s.g.Connected(0, 1))
+		s.Equal(2, s.g.ComponentCount())
+	})
+
+	s.Run("removing a bridge splits", func() {
+		s.g.RemoveEdge(2, 3)
+		s.False(s.g.Connected(0, 5))
+		s.True(s.g.Connected(3, 5))
+		s.Equal(3, s.g.ComponentCount())
+	})
+
+	s.Run("adding an edge merges", func() {
+		s.g.AddEdge(6, 4, struct{}{})
+		s.True(s.g.Connected(6, 3))
+		s.Equal(2, s.g.ComponentCount())
+	})
+
+	s.Run("new vertices start alone", func() {
+		s.g.AddVertex(7)
+		s.g.AddEdge(8, 8, struct{}{})
+		s.Equal(4, s.g.ComponentCount())
+		s.True(s.g.Connected(8, 8))
+		s.False(s.g.Connected(7, 8))
+		s.g.RemoveEdge(8, 8)
+		s.Equal(4, s.g.ComponentCount())
+	})
+
+	s.Run("removing a cut vertex splits into several components", func() {
+		s.g.RemoveVertex(1)
+		s.False(s.g.Connected(0, 1))
+		s.True(s.g.Connected(0, 2))
+		s.g.RemoveVertex(4)
+		s.True(s.g.Connected(3, 5))
+		s.False(s.g.Connected(5, 6))
+		s.Equal(5, s.g.ComponentCount())
+		s.g.RemoveVertex(7)
+		s.Equal(4, s.g.ComponentCount())
+	})
+
+	s.Run("disable", func() {
+		s.g.DisableConnectivityIndex()
+		s.False(s.g.HasConnectivityIndex())
+		s.g.AddEdge(0, 5, struct{}{})
+		s.True(s.g.Connected(2, 3))
+		s.Equal(3, s.g.ComponentCount())
+	})
+}
+
+func (s *ConnectivityTestSuite) TestJSONRebuildsIndex() {
+	g := NewUndirectedGraph[int, struct{}]()
+	g.EnableConnectivityIndex()
+	data, err := json.Marshal(s.g)
+	s.Require().NoError(err)
+	s.Require().NoError(json.Unmarshal(data, g))
+	s.Equal(2, g.ComponentCount())
+	s.True(g.Connected(0, 5))
+}
+
+func (s *ConnectivityTestSuite) TestMatchesRecomputation() {
+	rng := rand.New(rand.NewPCG(7, 7))
+	g := NewUndirectedGraph[int, struct{}]()
+	g.EnableConnectivityIndex()
+	const n = 30
+	for step := 0; step < 2000; step++ {
+		a, b := rng.IntN(n), rng.IntN(n)
+		switch op := rng.IntN(10); {
+		case op < 6:
+			g.AddEdge(a, b, struct{}{})
+		case op < 9:
+			g.RemoveEdge(a, b)
+		default:
+			g.RemoveVertex(a)
+		}
+		if step%50 == 0 {
+			fresh := newConnectivityIndex(g.g)
+			s.Equal(len(fresh.members), g.ComponentCount(), "step %d", step)
+			for x := 0; x < n; x++ {
+				for y := 0; y < n; y++ {
+					expected := g.HasVertex(x) && g.HasVertex(y) && fresh.component[x] == fresh.component[y]
+					s.Equal(expected, g.Connected(x, y), "step %d: %d-%d", step, x, y)
+				}
+			}
+		}
+	}
+}
+
+func TestConnectivityTestSuite(t *testing.T) {
+	suite.Run(t, new(ConnectivityTestSuite))
+}
@@ -0,0 +1,209 @@
+package graph
+
+import "math/bits"
+
+// DenseGraph stores edges in an adjacency matrix with one bit per vertex pair,
+// so HasEdge is constant time and costs n²/8 bytes whatever the number of
+// edges. Edge values live in a map keyed by matrix position, so they take
+// space only for the edges that exist. It suits graphs where most vertex
+// pairs are connected; for sparse graphs the adjacency-list types use far less
+// memory. Slots of removed vertices are reused.
+type DenseGraph[N comparable, E any] struct {
+	directed bool
+	nodes    []N
+	used     []bool
+	index    map[N]int
+	free     []int
+	bits     [][]uint64
+	values   map[[2]int]E
+}
+
+func NewDenseDirectedGraph[N comparable, E any](initialCapacity ...int) *DenseGraph[N, E] {
+	return newDenseGraph[N, E](true, initialCapacity...)
+}
+
+func NewDenseUndirectedGraph[N comparable, E any](initialCapacity ...int) *DenseGraph[N, E] {
+	return newDenseGraph[N, E](false, initialCapacity...)
+}
+
+func newDenseGraph[N comparable, E any](directed bool, initialCapacity ...int) *DenseGraph[N, E] {
+	capacity := 0
+	if len(initialCapacity) > 0 {
+		capacity = initialCapacity[0]
+	}
+	return &DenseGraph[N, E]{
+		directed: directed,
+		nodes:    make([]N, 0, capacity),
+		used:     make([]bool, 0, capacity),
+		index:    make(map[N]int, capacity),
+		bits:     make([][]uint64, 0, capacity),
+		values:   make(map[[2]int]E),
+	}
+}
+
+func (dg *DenseGraph[N, E]) AddVertex(node N) {
+	dg.slot(node)
+}
+
+// slot returns the matrix row of node, adding it if needed.
+func (dg *DenseGraph[N, E]) slot(node N) int {
+	if i, ok := dg.index[node]; ok {
+		return i
+	}
+	var i int
+	if n := len(dg.free); n > 0 {
+		i = dg.free[n-1]
+		dg.free = dg.free[:n-1]
+		dg.nodes[i] = node
+		dg.used[i] = true
+	} else {
+		i = len(dg.nodes)
+		dg.nodes = append(dg.nodes, node)
+		dg.used = append(dg.used, true)
+		words := (i + 64) / 64
+		for r := range dg.bits {
+			for len(dg.bits[r]) < words {
+				dg.bits[r] = append(dg.bits[r], 0)
+			}
+		}
+		dg.bits = append(dg.bits, make([]uint64, words))
+	}
+	dg.index[node] = i
+	return i
+}
+
+func (dg *DenseGraph[N, E]) RemoveVertex(node N) {
+	i, ok := dg.index[node]
+	if !ok {
+		return
+	}
+	for r := range dg.bits {
+		if dg.has(r, i) {
+			dg.unset(r, i)
+		}
+	}
+	for w, word := range dg.bits[i] {
+		for word != 0 {
+			dg.unset(i, w*64+bits.TrailingZeros64(word))
+			word &= word - 1
+		}
+	}
+	delete(dg.index, node)
+	dg.nodes[i] = *new(N)
+	dg.used[i] = false
+	dg.free = append(dg.free, i)
+}
+
+func (dg *DenseGraph[N, E]) set(i, j int, edge E) {
+	dg.bits[i][j/64] |= 1 << (j % 64)
+	dg.values[[2]int{i, j}] = edge
+}
+
+func (dg *DenseGraph[N, E]) unset(i, j int) {
+	dg.bits[i][j/64] &^= 1 << (j % 64)
+	delete(dg.values, [2]int{i, j})
+}
+
+func (dg *DenseGraph[N, E]) has(i, j int) bool {
+	return dg.bits[i][j/64]&(1<<(j%64)) != 0
+}
+
+func (dg *DenseGraph[N, E]) AddEdge(from, to N, edge E) {
+	i, j := dg.slot(from), dg.slot(to)
+	dg.set(i, j, edge)
+	if !dg.directed {
+		dg.set(j, i, edge)
+	}
+}
+
+func (dg *DenseGraph[N, E]) RemoveEdge(from, to N) {
+	i, okFrom := dg.index[from]
+	j, okTo := dg.index[to]
+	if !okFrom || !okTo {
+		return
+	}
+	dg.unset(i, j)
+	if !dg.directed {
+		dg.unset(j, i)
+	}
+}
+
+func (dg *DenseGraph[N, E]) Neighbors(node N) []N {
+	neighbors := []N{}
+	i, ok := dg.index[node]
+	if !ok {
+		return neighbors
+	}
+	for w, word := range dg.bits[i] {
+		for word != 0 {
+			b := bits.TrailingZeros64(word)
+			neighbors = append(neighbors, dg.nodes[w*64+b])
+			word &= word - 1
+		}
+	}
+	return neighbors
+}
+
+func (dg *DenseGraph[N, E]) HasVertex(node N) bool {
+	_, ok := dg.index[node]
+	return ok
+}
+
+func (dg *DenseGraph[N, E]) HasEdge(from, to N) bool {
+	i, okFrom := dg.index[from]
+	j, okTo := dg.index[to]
+	return okFrom && okTo && dg.has(i, j)
+}
+
+func (dg *DenseGraph[N, E]) Edge(from, to N) (E, bool) {
+	if !dg.HasEdge(from, to) {
+		var zero E
+		return zero, false
+	}
+	return dg.values[[2]int{dg.index[from], dg.index[to]}], true
+}
+
+func (dg *DenseGraph[N, E]) IsDirected() bool {
+	return dg.directed
+}
+
+// Vertices lists vertices by matrix slot.
+func (dg *DenseGraph[N, E]) Vertices() []N {
+	vs := make([]N, 0, len(dg.index))
+	for i, node := range dg.nodes {
+		if dg.used[i] {
+			vs = append(vs, node)
+		}
+	}
+	return vs
+}
+
+// Edges lists every edge once, in the same form as the adjacency-list types.
+func (dg *DenseGraph[N, E]) Edges() [][3]interface{} {
+	es := [][3]interface{}{}
+	for i := range dg.nodes {
+		if !dg.used[i] {
+			continue
+		}
+		for j := range dg.nodes {
+			if dg.has(i, j) && (dg.directed || j >= i) {
+				es = append(es, [3]interface{}{dg.nodes[i], dg.nodes[j], dg.values[[2]int{i, j}]})
+			}
+		}
+	}
+	return es
+}
+
+// Degree returns the number of outgoing edges of node; for undirected graphs
+// a self-loop counts once.
+func (dg *DenseGraph[N, E]) Degree(node N) int {
+	i, ok := dg.index[node]
+	if !ok {
+		return 0
+	}
+	count := 0
+	for _, word := range dg.bits[i] {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
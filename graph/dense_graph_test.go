@@ -0,0 +1,132 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DenseGraphTestSuite struct {
+	suite.Suite
+}
+
+func (s *DenseGraphTestSuite) TestDirected() {
+	g := NewDenseDirectedGraph[string, int]()
+	s.True(g.IsDirected())
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "c", 2)
+	g.AddVertex("d")
+
+	s.True(g.HasEdge("a", "b"))
+	s.False(g.HasEdge("b", "a"))
+	s.False(g.HasEdge("a", "z"))
+	edge, ok := g.Edge("b", "c")
+	s.True(ok)
+	s.Equal(2, edge)
+	s.ElementsMatch([]string{"a", "b", "c", "d"}, g.Vertices())
+	s.Equal([]string{"b"}, g.Neighbors("a"))
+	s.Empty(g.Neighbors("d"))
+	s.Empty(g.Neighbors("z"))
+
+	g.AddEdge("a", "b", 5)
+	edge, _ = g.Edge("a", "b")
+	s.Equal(5, edge)
+
+	g.RemoveEdge("a", "b")
+	s.False(g.HasEdge("a", "b"))
+	_, ok = g.Edge("a", "b")
+	s.False(ok)
+}
+
+func (s *DenseGraphTestSuite) TestUndirected() {
+	g := NewDenseUndirectedGraph[int, string](4)
+	s.False(g.IsDirected())
+	g.AddEdge(1, 2, "x")
+	g.AddEdge(2, 2, "loop")
+
+	s.True(g.HasEdge(2, 1))
+	edge, _ := g.Edge(2, 1)
+	s.Equal("x", edge)
+	s.ElementsMatch([]int{1, 2}, g.Neighbors(2))
+	s.Equal(2, g.Degree(2))
+	s.Len(g.Edges(), 2)
+
+	g.RemoveEdge(2, 1)
+	s.False(g.HasEdge(1, 2))
+	s.Equal([]int{2}, g.Neighbors(2))
+}
+
+func (s *DenseGraphTestSuite) TestRemoveVertexReusesSlot() {
+	g := NewDenseDirectedGraph[int, int]()
+	g.AddEdge(1, 2, 12)
+	g.AddEdge(2, 3, 23)
+	g.AddEdge(3, 2, 32)
+
+	s.Len(g.values, 3)
+	g.RemoveVertex(2)
+	s.Empty(g.values)
+	s.False(g.HasVertex(2))
+	s.Empty(g.Neighbors(1))
+	s.Empty(g.Neighbors(3))
+	s.ElementsMatch([]int{1, 3}, g.Vertices())
+	g.RemoveVertex(2)
+
+	// The new vertex takes vertex 2's slot and must not inherit its edges.
+	g.AddVertex(4)
+	s.Len(g.nodes, 3)
+	s.False(g.HasEdge(1, 4))
+	s.False(g.HasEdge(4, 3))
+	s.Empty(g.Neighbors(4))
+	_, ok := g.Edge(3, 4)
+	s.False(ok)
+}
+
+func (s *DenseGraphTestSuite) TestValuesOnlyForEdges() {
+	g := NewDenseUndirectedGraph[int, string](1000)
+	for i := 0; i < 1000; i++ {
+		g.AddVertex(i)
+	}
+	g.AddEdge(0, 999, "far")
+	s.Len(g.values, 2)
+	edge, ok := g.Edge(999, 0)
+	s.True(ok)
+	s.Equal("far", edge)
+	g.RemoveEdge(999, 0)
+	s.Empty(g.values)
+}
+
+func (s *DenseGraphTestSuite) TestGrowsPastWordBoundary() {
+	g := NewDenseDirectedGraph[int, int]()
+	for i := 0; i < 130; i++ {
+		g.AddEdge(0, i, i)
+	}
+	s.Equal(130, g.Degree(0))
+	s.Len(g.Neighbors(0), 130)
+	edge, ok := g.Edge(0, 129)
+	s.True(ok)
+	s.Equal(129, edge)
+	s.True(g.HasEdge(0, 64))
+	s.False(g.HasEdge(129, 0))
+}
+
+func (s *DenseGraphTestSuite) TestMatchesAdjacencyList() {
+	dense := NewDenseUndirectedGraph[int, float64]()
+	list := NewUndirectedGraph[int, float64]()
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {0, 2}, {3, 4}} {
+		w := float64(e[0] + e[1])
+		dense.AddEdge(e[0], e[1], w)
+		list.AddEdge(e[0], e[1], w)
+	}
+	s.True(IsIsomorphic[int, float64](dense, list, MatchOptions[int, float64]{}))
+
+	weight := func(w float64) float64 { return w }
+	fromDense, err := AllShortestPaths[int, float64](dense, 4, 1, weight)
+	s.Require().NoError(err)
+	fromList, err := AllShortestPaths[int, float64](list, 4, 1, weight)
+	s.Require().NoError(err)
+	s.ElementsMatch(fromList, fromDense)
+}
+
+func TestDenseGraphTestSuite(t *testing.T) {
+	suite.Run(t, new(DenseGraphTestSuite))
+}
@@ -1,7 +1,8 @@
 package graph
 
 type DirectedGraph[N comparable, E any] struct {
-	g *genericAdjacencyListGraph[N, E]
+	g      *genericAdjacencyListGraph[N, E]
+	events *eventHub[N, E]
 }
 
 func NewDirectedGraph[N comparable, E any]() *DirectedGraph[N, E] {
@@ -9,23 +10,31 @@ func NewDirectedGraph[N comparable, E any]() *DirectedGraph[N, E] {
 }
 
 func (dg *DirectedGraph[N, E]) AddVertex(node N) {
+	events := dg.events.vertexAddition(dg.g, node)
 	dg.g.addVertex(node)
+	dg.events.emit(events...)
 }
 
 func (dg *DirectedGraph[N, E]) RemoveVertex(node N) {
+	events := dg.events.vertexRemoval(dg.g, node, true)
 	dg.g.removeVertex(node)
+	dg.events.emit(events...)
 }
 
 func (dg *DirectedGraph[N, E]) AddEdge(from, to N, edge E) {
+	events := dg.events.edgeAddition(dg.g, from, to, edge)
 	dg.g.addVertex(from)
 	dg.g.addVertex(to)
 	dg.g.adj[from][to] = edge // Only one direction
+	dg.events.emit(events...)
 }
 
 func (dg *DirectedGraph[N, E]) RemoveEdge(from, to N) {
+	events := dg.events.edgeRemoval(dg.g, from, to)
 	if _, ok := dg.g.adj[from]; ok {
 		delete(dg.g.adj[from], to)
 	}
+	dg.events.emit(events...)
 }
 
 func (dg *DirectedGraph[N, E]) Neighbors(node N) []N {
@@ -40,6 +49,14 @@ func (dg *DirectedGraph[N, E]) HasEdge(from, to N) bool {
 	return dg.g.hasEdge(from, to)
 }
 
+func (dg *DirectedGraph[N, E]) Edge(from, to N) (E, bool) {
+	return dg.g.edge(from, to)
+}
+
+func (dg *DirectedGraph[N, E]) IsDirected() bool {
+	return true
+}
+
 func (dg *DirectedGraph[N, E]) Vertices() []N {
 	return dg.g.vertices()
 }
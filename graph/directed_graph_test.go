@@ -42,6 +42,15 @@ func (s *DirectedGraphTestSuite) TestDirectedGraph() {
 		s.Equal([]int{2}, neighbors1)
 		s.Empty(neighbors2)
 	})
+	s.Run("Edge", func() {
+		s.g.AddEdge(1, 2, testEdgeDirected{label: "a->b"})
+		edge, ok := s.g.Edge(1, 2)
+		s.True(ok)
+		s.Equal("a->b", edge.label)
+		_, ok = s.g.Edge(2, 1)
+		s.False(ok)
+		s.True(s.g.IsDirected())
+	})
 	s.Run("RemoveEdge", func() {
 		s.g.AddVertex(1)
 		s.g.AddVertex(2)
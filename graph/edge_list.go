@@ -0,0 +1,75 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteEdgeList writes one "from to [value]" line per edge, separated by
+// spaces, in the format read by networkx, SNAP and igraph. Isolated vertices
+// are written on a line of their own so they survive a round trip. A nil
+// formatEdge omits the value column.
+func WriteEdgeList[N comparable, E any](w io.Writer, g ReadOnlyGraph[N, E], formatNode func(N) string, formatEdge func(E) string) error {
+	bw := bufio.NewWriter(w)
+	connected := make(map[N]bool)
+	for _, e := range edgeEntries(g) {
+		connected[e.From] = true
+		connected[e.To] = true
+		line := formatNode(e.From) + " " + formatNode(e.To)
+		if formatEdge != nil {
+			line += " " + formatEdge(e.Value)
+		}
+		if _, err := fmt.Fprintln(bw, line); err != nil {
+			return err
+		}
+	}
+	for _, node := range g.Vertices() {
+		if connected[node] {
+			continue
+		}
+		if _, err := fmt.Fprintln(bw, formatNode(node)); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// ReadEdgeList adds the vertices and edges listed in r to g. Blank lines and
+// lines starting with '#' are skipped. Everything after the second field is
+// passed to parseEdge; when parseEdge is nil or the column is missing the edge
+// gets the zero value of E.
+func ReadEdgeList[N comparable, E any](r io.Reader, g Graph[N, E], parseNode func(string) (N, error), parseEdge func(string) (E, error)) error {
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		from, err := parseNode(fields[0])
+		if err != nil {
+			return fmt.Errorf("graph: edge list line %d: %w", lineNo, err)
+		}
+		if len(fields) == 1 {
+			g.AddVertex(from)
+			continue
+		}
+		to, err := parseNode(fields[1])
+		if err != nil {
+			return fmt.Errorf("graph: edge list line %d: %w", lineNo, err)
+		}
+		var edge E
+		if parseEdge != nil && len(fields) > 2 {
+			edge, err = parseEdge(strings.Join(fields[2:], " "))
+			if err != nil {
+				return fmt.Errorf("graph: edge list line %d: %w", lineNo, err)
+			}
+		}
+		g.AddEdge(from, to, edge)
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,73 @@
+package graph
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type EdgeListTestSuite struct {
+	suite.Suite
+}
+
+func parseStringNode(s string) (string, error) { return s, nil }
+
+func (s *EdgeListTestSuite) TestDirectedRoundTrip() {
+	g := NewDirectedGraph[int, float64]()
+	g.AddEdge(1, 2, 0.5)
+	g.AddEdge(2, 1, 1.5)
+	g.AddEdge(2, 3, 2)
+	g.AddVertex(4)
+
+	var buf bytes.Buffer
+	err := WriteEdgeList[int, float64](&buf, g, strconv.Itoa, func(w float64) string {
+		return strconv.FormatFloat(w, 'g', -1, 64)
+	})
+	s.NoError(err)
+
+	decoded := NewDirectedGraph[int, float64]()
+	err = ReadEdgeList[int, float64](&buf, decoded, strconv.Atoi, func(f string) (float64, error) {
+		return strconv.ParseFloat(f, 64)
+	})
+	s.NoError(err)
+	s.ElementsMatch([]int{1, 2, 3, 4}, decoded.Vertices())
+	s.Len(decoded.Edges(), 3)
+	w, ok := decoded.Edge(2, 1)
+	s.True(ok)
+	s.Equal(1.5, w)
+}
+
+func (s *EdgeListTestSuite) TestUndirectedRoundTrip() {
+	g := NewUndirectedGraph[string, struct{}]()
+	g.AddEdge("a", "b", struct{}{})
+	g.AddEdge("b", "c", struct{}{})
+
+	var buf bytes.Buffer
+	s.NoError(WriteEdgeList[string, struct{}](&buf, g, func(n string) string { return n }, nil))
+	s.Len(strings.Split(strings.TrimSpace(buf.String()), "\n"), 2)
+
+	decoded := NewUndirectedGraph[string, struct{}]()
+	s.NoError(ReadEdgeList[string, struct{}](&buf, decoded, parseStringNode, nil))
+	s.True(decoded.HasEdge("b", "a"))
+	s.True(decoded.HasEdge("c", "b"))
+	s.Len(decoded.Edges(), 2)
+}
+
+func (s *EdgeListTestSuite) TestReadSkipsCommentsAndReportsErrors() {
+	input := "# SNAP style header\n\n1 2\n2 3 {'weight': 3}\n"
+	g := NewDirectedGraph[int, string]()
+	s.NoError(ReadEdgeList[int, string](strings.NewReader(input), g, strconv.Atoi, parseStringNode))
+	edge, ok := g.Edge(2, 3)
+	s.True(ok)
+	s.Equal("{'weight': 3}", edge)
+
+	err := ReadEdgeList[int, string](strings.NewReader("1 x\n"), NewDirectedGraph[int, string](), strconv.Atoi, nil)
+	s.ErrorContains(err, "line 1")
+}
+
+func TestEdgeListTestSuite(t *testing.T) {
+	suite.Run(t, new(EdgeListTestSuite))
+}
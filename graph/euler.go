@@ -0,0 +1,126 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNoPath is returned, possibly wrapped, when a requested path or tour does
+// not exist in the graph.
+var ErrNoPath = errors.New("graph: no such path")
+
+// EulerianPath returns a walk that uses every edge exactly once, built with
+// Hierholzer's algorithm. The walk is returned as its sequence of vertices, so
+// it has one more entry than g has edges. A graph without edges yields an
+// empty path.
+func EulerianPath[N comparable, E any](g ReadOnlyGraph[N, E]) ([]N, error) {
+	return eulerian(g, false)
+}
+
+// EulerianCircuit is like EulerianPath but the walk must end where it starts.
+func EulerianCircuit[N comparable, E any](g ReadOnlyGraph[N, E]) ([]N, error) {
+	return eulerian(g, true)
+}
+
+func eulerian[N comparable, E any](g ReadOnlyGraph[N, E], circuit bool) ([]N, error) {
+	ig := newIndexedGraph[N, E](g)
+	directed := g.IsDirected()
+	edges := [][2]int{}
+	for i := range ig.adj {
+		for _, j := range ig.adj[i] {
+			if directed || j >= i {
+				edges = append(edges, [2]int{i, j})
+			}
+		}
+	}
+	if len(edges) == 0 {
+		return []N{}, nil
+	}
+
+	out := make([]int, ig.size())
+	in := make([]int, ig.size())
+	for _, e := range edges {
+		out[e[0]]++
+		in[e[1]]++
+	}
+	start := edges[0][0]
+	if directed {
+		starts, ends := 0, 0
+		for v := range out {
+			switch diff := out[v] - in[v]; {
+			case diff == 1:
+				starts++
+				start = v
+			case diff == -1:
+				ends++
+			case diff != 0:
+				return nil, fmt.Errorf("%w: vertex %v has in-degree %d and out-degree %d", ErrNoPath, ig.nodes[v], in[v], out[v])
+			}
+		}
+		if starts != ends || starts > 1 || (circuit && starts != 0) {
+			return nil, fmt.Errorf("%w: %d vertices have surplus out-degree", ErrNoPath, starts)
+		}
+	} else {
+		odd := 0
+		for v := range out {
+			if (out[v]+in[v])%2 == 1 {
+				if odd == 0 {
+					start = v
+				}
+				odd++
+			}
+		}
+		if odd > 2 || (circuit && odd != 0) {
+			return nil, fmt.Errorf("%w: %d vertices have odd degree", ErrNoPath, odd)
+		}
+	}
+
+	walk := hierholzer(ig.size(), edges, directed, start)
+	if len(walk) != len(edges)+1 {
+		return nil, fmt.Errorf("%w: edges are not all connected", ErrNoPath)
+	}
+	path := make([]N, len(walk))
+	for i, v := range walk {
+		path[i] = ig.nodes[v]
+	}
+	return path, nil
+}
+
+// hierholzer walks every edge reachable from start once and returns the
+// visited vertices. Undirected edges may be listed in either direction and
+// parallel edges are allowed.
+func hierholzer(n int, edges [][2]int, directed bool, start int) []int {
+	incident := make([][]int, n)
+	for id, e := range edges {
+		incident[e[0]] = append(incident[e[0]], id)
+		if !directed && e[0] != e[1] {
+			incident[e[1]] = append(incident[e[1]], id)
+		}
+	}
+	used := make([]bool, len(edges))
+	next := make([]int, n)
+	stack := []int{start}
+	walk := []int{}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		for next[v] < len(incident[v]) && used[incident[v][next[v]]] {
+			next[v]++
+		}
+		if next[v] == len(incident[v]) {
+			stack = stack[:len(stack)-1]
+			walk = append(walk, v)
+			continue
+		}
+		id := incident[v][next[v]]
+		used[id] = true
+		to := edges[id][1]
+		if !directed && to == v {
+			to = edges[id][0]
+		}
+		stack = append(stack, to)
+	}
+	for i, j := 0, len(walk)-1; i < j; i, j = i+1, j-1 {
+		walk[i], walk[j] = walk[j], walk[i]
+	}
+	return walk
+}
@@ -0,0 +1,92 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type EulerTestSuite struct {
+	suite.Suite
+}
+
+// walksEveryEdgeOnce checks that consecutive path entries are edges and that
+// each edge of g is used exactly once.
+func (s *EulerTestSuite) walksEveryEdgeOnce(g Graph[int, struct{}], path []int) {
+	used := map[[2]int]int{}
+	for i := 1; i < len(path); i++ {
+		from, to := path[i-1], path[i]
+		s.True(g.HasEdge(from, to), "missing edge %d-%d", from, to)
+		if !g.IsDirected() && from > to {
+			from, to = to, from
+		}
+		used[[2]int{from, to}]++
+	}
+	s.Len(used, len(edgeEntries(g)))
+	for e, count := range used {
+		s.Equal(1, count, "edge %v", e)
+	}
+}
+
+func (s *EulerTestSuite) TestUndirectedCircuit() {
+	// Two triangles sharing vertex 0, plus a self-loop.
+	g := undirectedFromEdges([][2]int{{0, 1}, {1, 2}, {2, 0}, {0, 3}, {3, 4}, {4, 0}, {2, 2}})
+	path, err := EulerianCircuit[int, struct{}](g)
+	s.NoError(err)
+	s.Len(path, 8)
+	s.Equal(path[0], path[len(path)-1])
+	s.walksEveryEdgeOnce(g, path)
+}
+
+func (s *EulerTestSuite) TestUndirectedPath() {
+	// Königsberg minus one bridge: exactly two odd vertices.
+	g := undirectedFromEdges([][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {0, 2}})
+	_, err := EulerianCircuit[int, struct{}](g)
+	s.True(errors.Is(err, ErrNoPath))
+
+	path, err := EulerianPath[int, struct{}](g)
+	s.NoError(err)
+	s.Len(path, 6)
+	s.ElementsMatch([]int{0, 2}, []int{path[0], path[len(path)-1]})
+	s.walksEveryEdgeOnce(g, path)
+
+	star := undirectedFromEdges([][2]int{{0, 1}, {0, 2}, {0, 3}})
+	_, err = EulerianPath[int, struct{}](star)
+	s.True(errors.Is(err, ErrNoPath))
+}
+
+func (s *EulerTestSuite) TestDirected() {
+	g := NewDirectedGraph[int, struct{}]()
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 2}, {3, 3}} {
+		g.AddEdge(e[0], e[1], struct{}{})
+	}
+	path, err := EulerianCircuit[int, struct{}](g)
+	s.NoError(err)
+	s.Len(path, 7)
+	s.walksEveryEdgeOnce(g, path)
+
+	g.RemoveEdge(3, 2)
+	path, err = EulerianPath[int, struct{}](g)
+	s.NoError(err)
+	s.Equal(3, path[len(path)-1])
+	s.walksEveryEdgeOnce(g, path)
+	_, err = EulerianCircuit[int, struct{}](g)
+	s.True(errors.Is(err, ErrNoPath))
+}
+
+func (s *EulerTestSuite) TestDisconnectedAndEmpty() {
+	g := undirectedFromEdges([][2]int{{0, 1}, {1, 2}, {2, 0}, {5, 6}, {6, 7}, {7, 5}})
+	_, err := EulerianCircuit[int, struct{}](g)
+	s.True(errors.Is(err, ErrNoPath))
+
+	empty := NewUndirectedGraph[int, struct{}]()
+	empty.AddVertex(1)
+	path, err := EulerianCircuit[int, struct{}](empty)
+	s.NoError(err)
+	s.Empty(path)
+}
+
+func TestEulerTestSuite(t *testing.T) {
+	suite.Run(t, new(EulerTestSuite))
+}
@@ -0,0 +1,182 @@
+package graph
+
+import "slices"
+
+type EventKind int
+
+const (
+	VertexAdded EventKind = iota
+	VertexRemoved
+	EdgeAdded
+	EdgeRemoved
+	EdgeUpdated
+)
+
+func (k EventKind) String() string {
+	switch k {
+	case VertexAdded:
+		return "VertexAdded"
+	case VertexRemoved:
+		return "VertexRemoved"
+	case EdgeAdded:
+		return "EdgeAdded"
+	case EdgeRemoved:
+		return "EdgeRemoved"
+	case EdgeUpdated:
+		return "EdgeUpdated"
+	default:
+		return "EventKind(?)"
+	}
+}
+
+// Event describes one change to a graph. Vertex is set for vertex events;
+// From, To and Edge for edge events, where Edge is the added, removed or new
+// payload. Previous holds the replaced payload of an EdgeUpdated event.
+type Event[N comparable, E any] struct {
+	Kind     EventKind
+	Vertex   N
+	From     N
+	To       N
+	Edge     E
+	Previous E
+}
+
+type eventListener[N comparable, E any] struct {
+	id int
+	fn func(Event[N, E])
+}
+
+// eventHub delivers events synchronously, in subscription order, after the
+// change they describe has been applied. A nil hub has no listeners.
+type eventHub[N comparable, E any] struct {
+	listeners []eventListener[N, E]
+	nextID    int
+}
+
+func (h *eventHub[N, E]) active() bool {
+	return h != nil && len(h.listeners) > 0
+}
+
+func (h *eventHub[N, E]) subscribe(fn func(Event[N, E])) func() {
+	h.nextID++
+	id := h.nextID
+	h.listeners = append(h.listeners, eventListener[N, E]{id: id, fn: fn})
+	return func() {
+		h.listeners = slices.DeleteFunc(h.listeners, func(l eventListener[N, E]) bool { return l.id == id })
+	}
+}
+
+func (h *eventHub[N, E]) emit(events ...Event[N, E]) {
+	if !h.active() {
+		return
+	}
+	for _, e := range events {
+		// A listener may unsubscribe itself, so iterate over a snapshot.
+		for _, l := range slices.Clone(h.listeners) {
+			l.fn(e)
+		}
+	}
+}
+
+// The methods below compute, before a change is applied, the events it will
+// cause. They return nil when nobody is listening.
+
+func (h *eventHub[N, E]) vertexAddition(g *genericAdjacencyListGraph[N, E], node N) []Event[N, E] {
+	if !h.active() || g.hasVertex(node) {
+		return nil
+	}
+	return []Event[N, E]{{Kind: VertexAdded, Vertex: node}}
+}
+
+// vertexRemoval reports every incident edge before the vertex itself.
+func (h *eventHub[N, E]) vertexRemoval(g *genericAdjacencyListGraph[N, E], node N, directed bool) []Event[N, E] {
+	if !h.active() || !g.hasVertex(node) {
+		return nil
+	}
+	events := []Event[N, E]{}
+	for to, edge := range g.adj[node] {
+		events = append(events, Event[N, E]{Kind: EdgeRemoved, From: node, To: to, Edge: edge})
+	}
+	if directed {
+		for from, neighbors := range g.adj {
+			if edge, ok := neighbors[node]; ok && from != node {
+				events = append(events, Event[N, E]{Kind: EdgeRemoved, From: from, To: node, Edge: edge})
+			}
+		}
+	}
+	return append(events, Event[N, E]{Kind: VertexRemoved, Vertex: node})
+}
+
+// edgeAddition reports new endpoints before the edge.
+func (h *eventHub[N, E]) edgeAddition(g *genericAdjacencyListGraph[N, E], from, to N, edge E) []Event[N, E] {
+	if !h.active() {
+		return nil
+	}
+	events := h.vertexAddition(g, from)
+	if from != to {
+		events = append(events, h.vertexAddition(g, to)...)
+	}
+	if previous, ok := g.edge(from, to); ok {
+		return append(events, Event[N, E]{Kind: EdgeUpdated, From: from, To: to, Edge: edge, Previous: previous})
+	}
+	return append(events, Event[N, E]{Kind: EdgeAdded, From: from, To: to, Edge: edge})
+}
+
+func (h *eventHub[N, E]) edgeRemoval(g *genericAdjacencyListGraph[N, E], from, to N) []Event[N, E] {
+	if !h.active() {
+		return nil
+	}
+	edge, ok := g.edge(from, to)
+	if !ok {
+		return nil
+	}
+	return []Event[N, E]{{Kind: EdgeRemoved, From: from, To: to, Edge: edge}}
+}
+
+func subscribeChannel[N comparable, E any](hub **eventHub[N, E], ch chan<- Event[N, E]) func() {
+	return subscribe(hub, func(e Event[N, E]) { ch <- e })
+}
+
+func subscribe[N comparable, E any](hub **eventHub[N, E], fn func(Event[N, E])) func() {
+	if *hub == nil {
+		*hub = &eventHub[N, E]{}
+	}
+	return (*hub).subscribe(fn)
+}
+
+// Subscribe registers listener to be called after every change to the graph,
+// in the order the changes happen. Adding an edge reports any new endpoints
+// first, and removing a vertex reports each of its edges before the vertex.
+// Listeners run synchronously in subscription order and must not modify the
+// graph. Decoding JSON into the graph replaces its contents without events.
+// The returned function removes the listener, with effect from the next
+// event even when called during delivery.
+func (dg *DirectedGraph[N, E]) Subscribe(listener func(Event[N, E])) (unsubscribe func()) {
+	return subscribe(&dg.events, listener)
+}
+
+// SubscribeChannel delivers events to ch like Subscribe. Each change blocks
+// until ch accepts its events, so use a buffered channel or a reader that
+// keeps up. The channel is not closed on unsubscribe.
+func (dg *DirectedGraph[N, E]) SubscribeChannel(ch chan<- Event[N, E]) (unsubscribe func()) {
+	return subscribeChannel(&dg.events, ch)
+}
+
+// Subscribe works like DirectedGraph.Subscribe. Edge events use the endpoint
+// order passed to AddEdge or RemoveEdge.
+func (ug *UndirectedGraph[N, E]) Subscribe(listener func(Event[N, E])) (unsubscribe func()) {
+	return subscribe(&ug.events, listener)
+}
+
+func (ug *UndirectedGraph[N, E]) SubscribeChannel(ch chan<- Event[N, E]) (unsubscribe func()) {
+	return subscribeChannel(&ug.events, ch)
+}
+
+// Subscribe works like UndirectedGraph.Subscribe.
+func (gw *GraphWrapper[N, E]) Subscribe(listener func(Event[N, E])) (unsubscribe func()) {
+	return subscribe(&gw.events, listener)
+}
+
+func (gw *GraphWrapper[N, E]) SubscribeChannel(ch chan<- Event[N, E]) (unsubscribe func()) {
+	return subscribeChannel(&gw.events, ch)
+}
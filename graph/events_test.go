@@ -0,0 +1,167 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type EventsTestSuite struct {
+	suite.Suite
+}
+
+type recorder[N comparable, E any] struct {
+	events []Event[N, E]
+}
+
+func (r *recorder[N, E]) record(e Event[N, E]) {
+	r.events = append(r.events, e)
+}
+
+func (r *recorder[N, E]) kinds() []EventKind {
+	kinds := make([]EventKind, len(r.events))
+	for i, e := range r.events {
+		kinds[i] = e.Kind
+	}
+	return kinds
+}
+
+func (s *EventsTestSuite) TestDirectedGraph() {
+	g := NewDirectedGraph[string, int]()
+	g.AddVertex("a")
+	r := &recorder[string, int]{}
+	g.Subscribe(r.record)
+
+	s.Run("add edge reports new endpoints first", func() {
+		g.AddEdge("a", "b", 1)
+		s.Equal([]Event[string, int]{
+			{Kind: VertexAdded, Vertex: "b"},
+			{Kind: EdgeAdded, From: "a", To: "b", Edge: 1},
+		}, r.events)
+	})
+
+	s.Run("replacing a payload is an update", func() {
+		r.events = nil
+		g.AddEdge("a", "b", 2)
+		s.Equal([]Event[string, int]{{Kind: EdgeUpdated, From: "a", To: "b", Edge: 2, Previous: 1}}, r.events)
+	})
+
+	s.Run("no-ops emit nothing", func() {
+		r.events = nil
+		g.AddVertex("a")
+		g.RemoveEdge("b", "a")
+		g.RemoveVertex("z")
+		s.Empty(r.events)
+	})
+
+	s.Run("remove vertex reports in and out edges first", func() {
+		g.AddEdge("c", "b", 3)
+		g.AddEdge("b", "b", 4)
+		r.events = nil
+		g.RemoveVertex("b")
+		s.Len(r.events, 4)
+		s.ElementsMatch([]Event[string, int]{
+			{Kind: EdgeRemoved, From: "a", To: "b", Edge: 2},
+			{Kind: EdgeRemoved, From: "c", To: "b", Edge: 3},
+			{Kind: EdgeRemoved, From: "b", To: "b", Edge: 4},
+		}, r.events[:3])
+		s.Equal(Event[string, int]{Kind: VertexRemoved, Vertex: "b"}, r.events[3])
+	})
+
+	s.Run("remove edge", func() {
+		r.events = nil
+		g.RemoveEdge("c", "a")
+		g.AddEdge("c", "a", 5)
+		g.RemoveEdge("c", "a")
+		s.Equal([]EventKind{EdgeAdded, EdgeRemoved}, r.kinds())
+		s.Equal(5, r.events[1].Edge)
+	})
+}
+
+func (s *EventsTestSuite) TestUndirectedGraph() {
+	g := NewUndirectedGraph[int, string]()
+	r := &recorder[int, string]{}
+	g.Subscribe(r.record)
+	g.EnableConnectivityIndex()
+
+	g.AddEdge(1, 2, "x")
+	g.AddEdge(2, 3, "y")
+	g.AddEdge(3, 2, "z")
+	s.Equal([]EventKind{VertexAdded, VertexAdded, EdgeAdded, VertexAdded, EdgeAdded, EdgeUpdated}, r.kinds())
+	s.Equal(Event[int, string]{Kind: EdgeUpdated, From: 3, To: 2, Edge: "z", Previous: "y"}, r.events[5])
+
+	r.events = nil
+	g.RemoveVertex(2)
+	s.Equal([]EventKind{EdgeRemoved, EdgeRemoved, VertexRemoved}, r.kinds())
+	s.ElementsMatch([]string{"x", "z"}, []string{r.events[0].Edge, r.events[1].Edge})
+	s.False(g.Connected(1, 3))
+}
+
+func (s *EventsTestSuite) TestGraphWrapper() {
+	g := NewGraphWrapper[int, int]()
+	r := &recorder[int, int]{}
+	g.Subscribe(r.record)
+	g.AddVertex(1)
+	g.AddEdge(1, 1, 7)
+	g.RemoveEdge(1, 1)
+	g.RemoveVertex(1)
+	s.Equal([]EventKind{VertexAdded, EdgeAdded, EdgeRemoved, VertexRemoved}, r.kinds())
+}
+
+func (s *EventsTestSuite) TestOrderingAndUnsubscribe() {
+	g := NewDirectedGraph[int, int]()
+	calls := []string{}
+	first := g.Subscribe(func(e Event[int, int]) { calls = append(calls, "first "+e.Kind.String()) })
+	g.Subscribe(func(e Event[int, int]) {
+		s.True(g.HasVertex(e.Vertex), "listeners run after the change")
+		calls = append(calls, "second "+e.Kind.String())
+	})
+
+	g.AddVertex(1)
+	s.Equal([]string{"first VertexAdded", "second VertexAdded"}, calls)
+
+	first()
+	first()
+	calls = nil
+	g.AddVertex(2)
+	s.Equal([]string{"second VertexAdded"}, calls)
+}
+
+func (s *EventsTestSuite) TestUnsubscribeDuringDelivery() {
+	g := NewDirectedGraph[int, int]()
+	count := 0
+	var unsubscribe func()
+	unsubscribe = g.Subscribe(func(Event[int, int]) {
+		count++
+		unsubscribe()
+	})
+	// Unsubscribing takes effect before the change's remaining events.
+	g.AddEdge(1, 2, 0)
+	s.Equal(1, count)
+	g.AddVertex(3)
+	s.Equal(1, count)
+}
+
+func (s *EventsTestSuite) TestChannel() {
+	g := NewUndirectedGraph[string, int]()
+	ch := make(chan Event[string, int], 8)
+	unsubscribe := g.SubscribeChannel(ch)
+
+	g.AddEdge("a", "b", 1)
+	s.Equal(Event[string, int]{Kind: VertexAdded, Vertex: "a"}, <-ch)
+	s.Equal(Event[string, int]{Kind: VertexAdded, Vertex: "b"}, <-ch)
+	s.Equal(Event[string, int]{Kind: EdgeAdded, From: "a", To: "b", Edge: 1}, <-ch)
+
+	unsubscribe()
+	g.AddVertex("c")
+	s.Empty(ch)
+}
+
+func (s *EventsTestSuite) TestEventKindString() {
+	s.Equal("EdgeRemoved", EdgeRemoved.String())
+	s.Equal("EventKind(?)", EventKind(42).String())
+}
+
+func TestEventsTestSuite(t *testing.T) {
+	suite.Run(t, new(EventsTestSuite))
+}
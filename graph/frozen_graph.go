@@ -0,0 +1,199 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// FrozenGraph is an immutable snapshot stored in compressed sparse row form:
+// the neighbours of vertex i are targets[offsets[i]:offsets[i+1]], sorted, so
+// neighbour scans touch contiguous memory and HasEdge is a binary search.
+// Algorithms that number vertices internally reuse the snapshot's numbering
+// instead of rebuilding it, which makes repeated analytics on a FrozenGraph
+// much cheaper. A FrozenGraph is safe for concurrent reads. It has no
+// mutating methods, so it satisfies ReadOnlyGraph but not Graph: functions
+// that fill a Graph, such as ReadGML, do not accept it.
+type FrozenGraph[N comparable, E any] struct {
+	directed bool
+	nodes    []N
+	index    map[N]int
+	offsets  []int
+	targets  []int
+	values   []E
+
+	once    sync.Once
+	indexed *indexedGraph[N]
+}
+
+// Freeze copies g into a FrozenGraph. Vertices are numbered in the order of
+// their %v formatting, so the result does not depend on map iteration order.
+func Freeze[N comparable, E any](g ReadOnlyGraph[N, E]) *FrozenGraph[N, E] {
+	nodes := g.Vertices()
+	keys := make(map[N]string, len(nodes))
+	for _, node := range nodes {
+		keys[node] = fmt.Sprintf("%v", node)
+	}
+	sort.SliceStable(nodes, func(i, j int) bool { return keys[nodes[i]] < keys[nodes[j]] })
+
+	fg := &FrozenGraph[N, E]{
+		directed: g.IsDirected(),
+		nodes:    nodes,
+		index:    make(map[N]int, len(nodes)),
+		offsets:  make([]int, len(nodes)+1),
+	}
+	for i, node := range nodes {
+		fg.index[node] = i
+	}
+	for i, node := range nodes {
+		row := []int{}
+		for _, neighbor := range g.Neighbors(node) {
+			row = append(row, fg.index[neighbor])
+		}
+		sort.Ints(row)
+		for _, j := range row {
+			edge, _ := g.Edge(node, nodes[j])
+			fg.targets = append(fg.targets, j)
+			fg.values = append(fg.values, edge)
+		}
+		fg.offsets[i+1] = len(fg.targets)
+	}
+	return fg
+}
+
+func (dg *DirectedGraph[N, E]) Freeze() *FrozenGraph[N, E] {
+	return Freeze[N, E](dg)
+}
+
+func (ug *UndirectedGraph[N, E]) Freeze() *FrozenGraph[N, E] {
+	return Freeze[N, E](ug)
+}
+
+func (gw *GraphWrapper[N, E]) Freeze() *FrozenGraph[N, E] {
+	return Freeze[N, E](gw)
+}
+
+func (dg *DenseGraph[N, E]) Freeze() *FrozenGraph[N, E] {
+	return Freeze[N, E](dg)
+}
+
+// position returns the CSR offset of the edge i -> j, or -1.
+func (fg *FrozenGraph[N, E]) position(i, j int) int {
+	lo, hi := fg.offsets[i], fg.offsets[i+1]
+	k := lo + sort.SearchInts(fg.targets[lo:hi], j)
+	if k < hi && fg.targets[k] == j {
+		return k
+	}
+	return -1
+}
+
+func (fg *FrozenGraph[N, E]) Neighbors(node N) []N {
+	i, ok := fg.index[node]
+	if !ok {
+		return []N{}
+	}
+	neighbors := make([]N, 0, fg.offsets[i+1]-fg.offsets[i])
+	for _, j := range fg.targets[fg.offsets[i]:fg.offsets[i+1]] {
+		neighbors = append(neighbors, fg.nodes[j])
+	}
+	return neighbors
+}
+
+func (fg *FrozenGraph[N, E]) HasVertex(node N) bool {
+	_, ok := fg.index[node]
+	return ok
+}
+
+func (fg *FrozenGraph[N, E]) HasEdge(from, to N) bool {
+	_, ok := fg.Edge(from, to)
+	return ok
+}
+
+func (fg *FrozenGraph[N, E]) Edge(from, to N) (E, bool) {
+	i, okFrom := fg.index[from]
+	j, okTo := fg.index[to]
+	if okFrom && okTo {
+		if k := fg.position(i, j); k >= 0 {
+			return fg.values[k], true
+		}
+	}
+	var zero E
+	return zero, false
+}
+
+func (fg *FrozenGraph[N, E]) IsDirected() bool {
+	return fg.directed
+}
+
+func (fg *FrozenGraph[N, E]) Vertices() []N {
+	vs := make([]N, len(fg.nodes))
+	copy(vs, fg.nodes)
+	return vs
+}
+
+// Edges lists every edge once, in the same form as the adjacency-list types.
+func (fg *FrozenGraph[N, E]) Edges() [][3]interface{} {
+	es := [][3]interface{}{}
+	for i := range fg.nodes {
+		for k := fg.offsets[i]; k < fg.offsets[i+1]; k++ {
+			if j := fg.targets[k]; fg.directed || j >= i {
+				es = append(es, [3]interface{}{fg.nodes[i], fg.nodes[j], fg.values[k]})
+			}
+		}
+	}
+	return es
+}
+
+// Degree returns the number of outgoing edges of node; for undirected graphs
+// a self-loop counts once.
+func (fg *FrozenGraph[N, E]) Degree(node N) int {
+	i, ok := fg.index[node]
+	if !ok {
+		return 0
+	}
+	return fg.offsets[i+1] - fg.offsets[i]
+}
+
+func (fg *FrozenGraph[N, E]) VertexCount() int {
+	return len(fg.nodes)
+}
+
+// EdgeCount counts undirected edges once.
+func (fg *FrozenGraph[N, E]) EdgeCount() int {
+	if fg.directed {
+		return len(fg.targets)
+	}
+	loops := 0
+	for i := range fg.nodes {
+		if fg.position(i, i) >= 0 {
+			loops++
+		}
+	}
+	return (len(fg.targets)-loops)/2 + loops
+}
+
+// indexedView shares the CSR arrays with an indexedGraph built on first use.
+// Freeze already numbers vertices in sorted order, so the view serves both
+// newIndexedGraph and newSortedIndexedGraph. Every caller gets the same view,
+// whose nodes and adjacency rows are the frozen graph's own slices, so it must
+// not be modified.
+func (fg *FrozenGraph[N, E]) indexedView() *indexedGraph[N] {
+	fg.once.Do(func() {
+		ig := &indexedGraph[N]{
+			nodes:  fg.nodes,
+			index:  fg.index,
+			adj:    make([][]int, len(fg.nodes)),
+			adjSet: make([]map[int]bool, len(fg.nodes)),
+		}
+		for i := range fg.nodes {
+			row := fg.targets[fg.offsets[i]:fg.offsets[i+1]:fg.offsets[i+1]]
+			ig.adj[i] = row
+			ig.adjSet[i] = make(map[int]bool, len(row))
+			for _, j := range row {
+				ig.adjSet[i][j] = true
+			}
+		}
+		fg.indexed = ig
+	})
+	return fg.indexed
+}
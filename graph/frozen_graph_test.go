@@ -0,0 +1,119 @@
+package graph
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FrozenGraphTestSuite struct {
+	suite.Suite
+}
+
+func (s *FrozenGraphTestSuite) TestFreezeDirected() {
+	g := NewDirectedGraph[string, int]()
+	g.AddEdge("b", "a", 1)
+	g.AddEdge("b", "c", 2)
+	g.AddEdge("a", "c", 3)
+	g.AddVertex("d")
+
+	fg := g.Freeze()
+	s.True(fg.IsDirected())
+	s.Equal([]string{"a", "b", "c", "d"}, fg.Vertices())
+	s.Equal([]string{"a", "c"}, fg.Neighbors("b"))
+	s.Empty(fg.Neighbors("d"))
+	s.Empty(fg.Neighbors("z"))
+	s.True(fg.HasEdge("b", "a"))
+	s.False(fg.HasEdge("a", "b"))
+	edge, ok := fg.Edge("a", "c")
+	s.True(ok)
+	s.Equal(3, edge)
+	_, ok = fg.Edge("z", "a")
+	s.False(ok)
+	s.Equal(3, fg.EdgeCount())
+	s.Equal(4, fg.VertexCount())
+	s.Equal(2, fg.Degree("b"))
+	s.Len(fg.Edges(), 3)
+
+	// The snapshot does not follow later changes to the source.
+	g.AddEdge("d", "a", 4)
+	s.False(fg.HasEdge("d", "a"))
+}
+
+func (s *FrozenGraphTestSuite) TestFreezeUndirected() {
+	g := NewUndirectedGraph[int, string]()
+	g.AddEdge(1, 2, "x")
+	g.AddEdge(2, 3, "y")
+	g.AddEdge(3, 3, "loop")
+
+	fg := g.Freeze()
+	s.False(fg.IsDirected())
+	s.True(fg.HasEdge(2, 1))
+	s.Equal([]int{2, 3}, fg.Neighbors(3))
+	s.Equal(3, fg.EdgeCount())
+	s.Len(fg.Edges(), 3)
+
+	dense := NewDenseUndirectedGraph[int, string]()
+	dense.AddEdge(1, 2, "x")
+	s.Equal(1, dense.Freeze().EdgeCount())
+	s.Empty(NewGraphWrapper[int, string]().Freeze().Vertices())
+}
+
+func (s *FrozenGraphTestSuite) TestReadOnly() {
+	var g any = NewDirectedGraph[int, int]().Freeze()
+	_, readable := g.(ReadOnlyGraph[int, int])
+	s.True(readable)
+	// Not being a Graph keeps it out of readers such as ReadEdgeList.
+	_, writable := g.(Graph[int, int])
+	s.False(writable)
+}
+
+func (s *FrozenGraphTestSuite) TestAlgorithmsAgree() {
+	g := NewUndirectedGraph[int, float64]()
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {0, 2}, {3, 4}, {4, 5}, {5, 3}} {
+		g.AddEdge(e[0], e[1], float64(e[0]+e[1]+1))
+	}
+	fg := g.Freeze()
+	weight := func(w float64) float64 { return w }
+
+	want, err := KShortestPaths[int, float64](g, 1, 5, 3, weight)
+	s.Require().NoError(err)
+	got, err := KShortestPaths[int, float64](fg, 1, 5, 3, weight)
+	s.Require().NoError(err)
+	s.Equal(want, got)
+
+	path, err := HamiltonianPath[int, float64](fg)
+	s.Require().NoError(err)
+	s.Len(path, 6)
+	for i := 1; i < len(path); i++ {
+		s.True(fg.HasEdge(path[i-1], path[i]))
+	}
+
+	s.True(IsIsomorphic[int, float64](g, fg, MatchOptions[int, float64]{}))
+	s.Same(fg.indexedView(), newIndexedGraph[int, float64](fg))
+	s.Same(fg.indexedView(), newSortedIndexedGraph[int, float64](fg))
+}
+
+func (s *FrozenGraphTestSuite) TestConcurrentReads() {
+	g := NewDirectedGraph[int, int]()
+	for i := 0; i < 20; i++ {
+		g.AddEdge(i, (i+1)%20, i)
+	}
+	fg := g.Freeze()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			path, err := HamiltonianPath[int, int](fg)
+			s.NoError(err)
+			s.Len(path, 20)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestFrozenGraphTestSuite(t *testing.T) {
+	suite.Run(t, new(FrozenGraphTestSuite))
+}
@@ -0,0 +1,82 @@
+package generate
+
+import (
+	"fmt"
+
+	"github.com/raj1kshtz/go-structurarium/graph"
+)
+
+// Complete connects every pair of the n nodes, in both directions when g is
+// directed.
+func Complete[N comparable, E any](g graph.Graph[N, E], n int, f Factories[N, E]) error {
+	if err := checkNodeCount(n); err != nil {
+		return err
+	}
+	nodes := f.nodes(g, n)
+	for i := range nodes {
+		for j := range nodes {
+			if i == j || (!g.IsDirected() && j < i) {
+				continue
+			}
+			f.connect(g, nodes[i], nodes[j])
+		}
+	}
+	return nil
+}
+
+// Star connects node 0 to each of the other n-1 nodes.
+func Star[N comparable, E any](g graph.Graph[N, E], n int, f Factories[N, E]) error {
+	if err := checkNodeCount(n); err != nil {
+		return err
+	}
+	nodes := f.nodes(g, n)
+	for i := 1; i < n; i++ {
+		f.connect(g, nodes[0], nodes[i])
+	}
+	return nil
+}
+
+// Path connects the n nodes in a line, each to the next.
+func Path[N comparable, E any](g graph.Graph[N, E], n int, f Factories[N, E]) error {
+	if err := checkNodeCount(n); err != nil {
+		return err
+	}
+	nodes := f.nodes(g, n)
+	for i := 1; i < n; i++ {
+		f.connect(g, nodes[i-1], nodes[i])
+	}
+	return nil
+}
+
+// Cycle connects the n nodes in a ring; n must be at least 3.
+func Cycle[N comparable, E any](g graph.Graph[N, E], n int, f Factories[N, E]) error {
+	if n < 3 {
+		return fmt.Errorf("generate: a cycle needs at least 3 nodes, got %d", n)
+	}
+	nodes := f.nodes(g, n)
+	for i := range nodes {
+		f.connect(g, nodes[i], nodes[(i+1)%n])
+	}
+	return nil
+}
+
+// Grid lays out rows*cols nodes, numbered row by row, and connects each node
+// to its right and lower neighbours.
+func Grid[N comparable, E any](g graph.Graph[N, E], rows, cols int, f Factories[N, E]) error {
+	if rows < 0 || cols < 0 {
+		return fmt.Errorf("generate: grid dimensions must not be negative, got %dx%d", rows, cols)
+	}
+	nodes := f.nodes(g, rows*cols)
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			i := r*cols + c
+			if c+1 < cols {
+				f.connect(g, nodes[i], nodes[i+1])
+			}
+			if r+1 < rows {
+				f.connect(g, nodes[i], nodes[i+cols])
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/raj1kshtz/go-structurarium/graph"
+	"github.com/stretchr/testify/suite"
+)
+
+func intFactories() Factories[int, int] {
+	return Factories[int, int]{
+		Node: func(i int) int { return i },
+		Edge: func(from, to int) int { return from*100 + to },
+	}
+}
+
+type ClassicGeneratorsTestSuite struct {
+	suite.Suite
+}
+
+func (s *ClassicGeneratorsTestSuite) TestComplete() {
+	ug := graph.NewUndirectedGraph[int, int]()
+	s.NoError(Complete[int, int](ug, 5, intFactories()))
+	s.Len(ug.Vertices(), 5)
+	s.Len(ug.Edges(), 10)
+
+	dg := graph.NewDirectedGraph[int, int]()
+	s.NoError(Complete[int, int](dg, 4, intFactories()))
+	s.Len(dg.Edges(), 12)
+	w, ok := dg.Edge(3, 1)
+	s.True(ok)
+	s.Equal(301, w)
+}
+
+func (s *ClassicGeneratorsTestSuite) TestStarPathCycle() {
+	star := graph.NewUndirectedGraph[int, int]()
+	s.NoError(Star[int, int](star, 6, intFactories()))
+	s.Len(star.Neighbors(0), 5)
+	s.Equal([]int{0}, star.Neighbors(3))
+
+	path := graph.NewDirectedGraph[int, int]()
+	s.NoError(Path[int, int](path, 4, intFactories()))
+	s.Len(path.Edges(), 3)
+	s.True(path.HasEdge(2, 3))
+	s.False(path.HasEdge(3, 0))
+
+	cycle := graph.NewDirectedGraph[int, int]()
+	s.NoError(Cycle[int, int](cycle, 4, intFactories()))
+	s.Len(cycle.Edges(), 4)
+	s.True(cycle.HasEdge(3, 0))
+	s.Error(Cycle[int, int](graph.NewDirectedGraph[int, int](), 2, intFactories()))
+}
+
+func (s *ClassicGeneratorsTestSuite) TestGrid() {
+	g := graph.NewUndirectedGraph[int, int]()
+	s.NoError(Grid[int, int](g, 3, 4, intFactories()))
+	s.Len(g.Vertices(), 12)
+	s.Len(g.Edges(), 3*3+2*4)
+	s.ElementsMatch([]int{1, 4}, g.Neighbors(0))
+	s.ElementsMatch([]int{1, 4, 6, 9}, g.Neighbors(5))
+	s.Error(Grid[int, int](g, -1, 2, intFactories()))
+}
+
+func (s *ClassicGeneratorsTestSuite) TestCustomNodeType() {
+	type cell struct{ row, col int }
+	f := Factories[cell, float64]{
+		Node: func(i int) cell { return cell{row: i / 2, col: i % 2} },
+		Edge: func(from, to cell) float64 { return 1 },
+	}
+	g := graph.NewUndirectedGraph[cell, float64]()
+	s.NoError(Grid[cell, float64](g, 2, 2, f))
+	s.True(g.HasEdge(cell{0, 0}, cell{1, 0}))
+	s.True(g.HasEdge(cell{1, 1}, cell{0, 1}))
+	s.False(g.HasEdge(cell{0, 0}, cell{1, 1}))
+}
+
+func TestClassicGeneratorsTestSuite(t *testing.T) {
+	suite.Run(t, new(ClassicGeneratorsTestSuite))
+}
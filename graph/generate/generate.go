@@ -0,0 +1,46 @@
+package generate
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/raj1kshtz/go-structurarium/graph"
+)
+
+// Factories builds the nodes and edge payloads a generator adds. Node receives
+// the generator's node index, from 0 to n-1.
+type Factories[N comparable, E any] struct {
+	Node func(i int) N
+	Edge func(from, to N) E
+}
+
+func (f Factories[N, E]) nodes(g graph.Graph[N, E], n int) []N {
+	nodes := make([]N, n)
+	for i := range nodes {
+		nodes[i] = f.Node(i)
+		g.AddVertex(nodes[i])
+	}
+	return nodes
+}
+
+func (f Factories[N, E]) connect(g graph.Graph[N, E], from, to N) {
+	g.AddEdge(from, to, f.Edge(from, to))
+}
+
+func newRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15))
+}
+
+func checkNodeCount(n int) error {
+	if n < 0 {
+		return fmt.Errorf("generate: node count must not be negative, got %d", n)
+	}
+	return nil
+}
+
+func checkProbability(name string, p float64) error {
+	if p < 0 || p > 1 {
+		return fmt.Errorf("generate: %s must be between 0 and 1, got %v", name, p)
+	}
+	return nil
+}
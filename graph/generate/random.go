@@ -0,0 +1,127 @@
+package generate
+
+import (
+	"fmt"
+
+	"github.com/raj1kshtz/go-structurarium/graph"
+)
+
+// ErdosRenyi builds a G(n, p) graph: every ordered pair (directed) or
+// unordered pair (undirected) of distinct nodes is connected with probability p.
+func ErdosRenyi[N comparable, E any](g graph.Graph[N, E], n int, p float64, seed uint64, f Factories[N, E]) error {
+	if err := checkNodeCount(n); err != nil {
+		return err
+	}
+	if err := checkProbability("p", p); err != nil {
+		return err
+	}
+	rng := newRand(seed)
+	nodes := f.nodes(g, n)
+	for i := range nodes {
+		for j := range nodes {
+			if i == j || (!g.IsDirected() && j < i) {
+				continue
+			}
+			if rng.Float64() < p {
+				f.connect(g, nodes[i], nodes[j])
+			}
+		}
+	}
+	return nil
+}
+
+// BarabasiAlbert grows a scale-free graph by preferential attachment. It
+// starts from a star of m+1 nodes and links every further node to m distinct
+// existing nodes picked with probability proportional to their degree. In a
+// directed graph the new edges point from the new node to the existing ones.
+func BarabasiAlbert[N comparable, E any](g graph.Graph[N, E], n, m int, seed uint64, f Factories[N, E]) error {
+	if m < 1 || m >= n {
+		return fmt.Errorf("generate: Barabási–Albert needs 1 <= m < n, got m=%d n=%d", m, n)
+	}
+	rng := newRand(seed)
+	nodes := f.nodes(g, n)
+	// repeated holds each node once per incident edge, so a uniform pick from
+	// it is a degree-weighted pick.
+	repeated := make([]int, 0, 2*n*m)
+	for i := 1; i <= m; i++ {
+		f.connect(g, nodes[i], nodes[0])
+		repeated = append(repeated, 0, i)
+	}
+	for source := m + 1; source < n; source++ {
+		targets := make(map[int]bool, m)
+		order := make([]int, 0, m)
+		for len(order) < m {
+			t := repeated[rng.IntN(len(repeated))]
+			if !targets[t] {
+				targets[t] = true
+				order = append(order, t)
+			}
+		}
+		for _, t := range order {
+			f.connect(g, nodes[source], nodes[t])
+			repeated = append(repeated, source, t)
+		}
+	}
+	return nil
+}
+
+// WattsStrogatz builds a small-world graph: a ring where each node is linked
+// to its k nearest neighbours (k/2 on each side), after which every ring edge
+// is rewired to a random endpoint with probability beta.
+func WattsStrogatz[N comparable, E any](g graph.Graph[N, E], n, k int, beta float64, seed uint64, f Factories[N, E]) error {
+	if k < 2 || k%2 != 0 || k >= n {
+		return fmt.Errorf("generate: Watts–Strogatz needs an even k with 2 <= k < n, got k=%d n=%d", k, n)
+	}
+	if err := checkProbability("beta", beta); err != nil {
+		return err
+	}
+	rng := newRand(seed)
+	nodes := f.nodes(g, n)
+	linked := func(a, b int) bool {
+		return g.HasEdge(nodes[a], nodes[b]) || g.HasEdge(nodes[b], nodes[a])
+	}
+	for j := 1; j <= k/2; j++ {
+		for i := 0; i < n; i++ {
+			f.connect(g, nodes[i], nodes[(i+j)%n])
+		}
+	}
+	for j := 1; j <= k/2; j++ {
+		for i := 0; i < n; i++ {
+			if rng.Float64() >= beta {
+				continue
+			}
+			target := rng.IntN(n)
+			if target == i || linked(i, target) {
+				continue
+			}
+			g.RemoveEdge(nodes[i], nodes[(i+j)%n])
+			f.connect(g, nodes[i], nodes[target])
+		}
+	}
+	return nil
+}
+
+// RandomDAG shuffles the n nodes into a random topological order and adds each
+// forward edge with probability p, so the result never contains a cycle.
+func RandomDAG[N comparable, E any](g graph.Graph[N, E], n int, p float64, seed uint64, f Factories[N, E]) error {
+	if !g.IsDirected() {
+		return fmt.Errorf("generate: RandomDAG requires a directed graph")
+	}
+	if err := checkNodeCount(n); err != nil {
+		return err
+	}
+	if err := checkProbability("p", p); err != nil {
+		return err
+	}
+	rng := newRand(seed)
+	nodes := f.nodes(g, n)
+	order := rng.Perm(n)
+	for i := range order {
+		for j := i + 1; j < n; j++ {
+			if rng.Float64() < p {
+				f.connect(g, nodes[order[i]], nodes[order[j]])
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/raj1kshtz/go-structurarium/graph"
+	"github.com/stretchr/testify/suite"
+)
+
+type RandomGeneratorsTestSuite struct {
+	suite.Suite
+}
+
+func (s *RandomGeneratorsTestSuite) sameEdges(a, b graph.Graph[int, int], n int) {
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			s.Equal(a.HasEdge(i, j), b.HasEdge(i, j), "edge %d-%d", i, j)
+		}
+	}
+}
+
+func (s *RandomGeneratorsTestSuite) TestErdosRenyi() {
+	a := graph.NewUndirectedGraph[int, int]()
+	b := graph.NewUndirectedGraph[int, int]()
+	s.NoError(ErdosRenyi[int, int](a, 30, 0.2, 42, intFactories()))
+	s.NoError(ErdosRenyi[int, int](b, 30, 0.2, 42, intFactories()))
+	s.sameEdges(a, b, 30)
+	s.NotEmpty(a.Edges())
+
+	empty := graph.NewDirectedGraph[int, int]()
+	s.NoError(ErdosRenyi[int, int](empty, 10, 0, 1, intFactories()))
+	s.Empty(empty.Edges())
+	full := graph.NewDirectedGraph[int, int]()
+	s.NoError(ErdosRenyi[int, int](full, 10, 1, 1, intFactories()))
+	s.Len(full.Edges(), 90)
+
+	s.Error(ErdosRenyi[int, int](graph.NewDirectedGraph[int, int](), 10, 1.5, 1, intFactories()))
+}
+
+func (s *RandomGeneratorsTestSuite) TestBarabasiAlbert() {
+	a := graph.NewUndirectedGraph[int, int]()
+	b := graph.NewUndirectedGraph[int, int]()
+	s.NoError(BarabasiAlbert[int, int](a, 50, 3, 7, intFactories()))
+	s.NoError(BarabasiAlbert[int, int](b, 50, 3, 7, intFactories()))
+	s.sameEdges(a, b, 50)
+	s.Len(a.Edges(), 3*(50-3))
+	for i := 4; i < 50; i++ {
+		s.GreaterOrEqual(len(a.Neighbors(i)), 3)
+	}
+	s.Error(BarabasiAlbert[int, int](graph.NewUndirectedGraph[int, int](), 3, 3, 1, intFactories()))
+}
+
+func (s *RandomGeneratorsTestSuite) TestWattsStrogatz() {
+	lattice := graph.NewUndirectedGraph[int, int]()
+	s.NoError(WattsStrogatz[int, int](lattice, 10, 4, 0, 1, intFactories()))
+	s.Len(lattice.Edges(), 20)
+	s.ElementsMatch([]int{8, 9, 1, 2}, lattice.Neighbors(0))
+
+	a := graph.NewUndirectedGraph[int, int]()
+	b := graph.NewUndirectedGraph[int, int]()
+	s.NoError(WattsStrogatz[int, int](a, 40, 4, 0.3, 99, intFactories()))
+	s.NoError(WattsStrogatz[int, int](b, 40, 4, 0.3, 99, intFactories()))
+	s.sameEdges(a, b, 40)
+	s.Len(a.Edges(), 80)
+
+	s.Error(WattsStrogatz[int, int](graph.NewUndirectedGraph[int, int](), 10, 3, 0.1, 1, intFactories()))
+}
+
+func (s *RandomGeneratorsTestSuite) TestRandomDAG() {
+	g := graph.NewDirectedGraph[int, int]()
+	s.NoError(RandomDAG[int, int](g, 25, 0.4, 3, intFactories()))
+	s.NotEmpty(g.Edges())
+	s.False(hasCycle(g))
+
+	again := graph.NewDirectedGraph[int, int]()
+	s.NoError(RandomDAG[int, int](again, 25, 0.4, 3, intFactories()))
+	s.sameEdges(g, again, 25)
+
+	s.Error(RandomDAG[int, int](graph.NewUndirectedGraph[int, int](), 5, 0.5, 1, intFactories()))
+}
+
+func hasCycle(g *graph.DirectedGraph[int, int]) bool {
+	const (
+		unvisited = iota
+		active
+		done
+	)
+	state := map[int]int{}
+	var visit func(n int) bool
+	visit = func(n int) bool {
+		state[n] = active
+		for _, next := range g.Neighbors(n) {
+			if state[next] == active || (state[next] == unvisited && visit(next)) {
+				return true
+			}
+		}
+		state[n] = done
+		return false
+	}
+	for _, v := range g.Vertices() {
+		if state[v] == unvisited && visit(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRandomGeneratorsTestSuite(t *testing.T) {
+	suite.Run(t, new(RandomGeneratorsTestSuite))
+}
@@ -0,0 +1,288 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var gmlKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+type gmlPair struct {
+	key   string
+	value any // int, float64, string or []gmlPair
+}
+
+// WriteGML writes g in the Graph Modelling Language. Nodes get sequential
+// integer ids and their NodeID as label; "id", "label", "source" and "target"
+// are reserved and cannot be used as attribute names. GML has no boolean type,
+// so bool attributes are written as 1 or 0.
+func WriteGML[N comparable, E any](w io.Writer, g ReadOnlyGraph[N, E], mapping AttributeMapping[N, E]) error {
+	ids := make(map[N]string)
+	nodes := g.Vertices()
+	for _, node := range nodes {
+		ids[node] = mapping.nodeID(node)
+	}
+	sort.SliceStable(nodes, func(i, j int) bool { return ids[nodes[i]] < ids[nodes[j]] })
+	edges := edgeEntries(g)
+	orderEdgeEntries(edges, ids, g.IsDirected())
+
+	bw := bufio.NewWriter(w)
+	directed := 0
+	if g.IsDirected() {
+		directed = 1
+	}
+	fmt.Fprintf(bw, "graph [\n  directed %d\n", directed)
+	index := make(map[N]int)
+	for i, node := range nodes {
+		index[node] = i
+		fmt.Fprintf(bw, "  node [\n    id %d\n    label %s\n", i, gmlQuote(ids[node]))
+		if err := writeGMLAttributes(bw, mapping.nodeAttributes(node), "id", "label"); err != nil {
+			return err
+		}
+		fmt.Fprintln(bw, "  ]")
+	}
+	for _, e := range edges {
+		fmt.Fprintf(bw, "  edge [\n    source %d\n    target %d\n", index[e.From], index[e.To])
+		if err := writeGMLAttributes(bw, mapping.edgeAttributes(e.Value), "source", "target"); err != nil {
+			return err
+		}
+		fmt.Fprintln(bw, "  ]")
+	}
+	fmt.Fprintln(bw, "]")
+	return bw.Flush()
+}
+
+// ReadGML adds the nodes and edges of a GML document to g. A node's label is
+// used as its id for ParseNode when present. Nested lists such as yEd's
+// graphics blocks are skipped.
+func ReadGML[N comparable, E any](r io.Reader, g Graph[N, E], mapping AttributeMapping[N, E]) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	tokens, err := tokenizeGML(string(data))
+	if err != nil {
+		return err
+	}
+	pos := 0
+	root, err := parseGMLList(tokens, &pos, false)
+	if err != nil {
+		return err
+	}
+
+	var body []gmlPair
+	for _, p := range root {
+		if list, ok := p.value.([]gmlPair); ok && p.key == "graph" {
+			body = list
+			break
+		}
+	}
+	if body == nil {
+		return fmt.Errorf("graph: GML document has no graph block")
+	}
+
+	directed := false
+	for _, p := range body {
+		if p.key == "directed" {
+			directed = p.value == 1
+		}
+	}
+	if directed != g.IsDirected() {
+		return fmt.Errorf("graph: cannot read directed=%t GML into directed=%t graph", directed, g.IsDirected())
+	}
+
+	nodes := make(map[string]N)
+	for _, p := range body {
+		list, ok := p.value.([]gmlPair)
+		if !ok || p.key != "node" {
+			continue
+		}
+		var id, label string
+		attrs := Attributes{}
+		for _, field := range list {
+			switch field.key {
+			case "id":
+				id = fmt.Sprint(field.value)
+			case "label":
+				label = fmt.Sprint(field.value)
+			default:
+				if _, nested := field.value.([]gmlPair); !nested {
+					attrs[field.key] = field.value
+				}
+			}
+		}
+		if label == "" {
+			label = id
+		}
+		node, err := mapping.parseNode(label, attrs)
+		if err != nil {
+			return err
+		}
+		nodes[id] = node
+		g.AddVertex(node)
+	}
+
+	for _, p := range body {
+		list, ok := p.value.([]gmlPair)
+		if !ok || p.key != "edge" {
+			continue
+		}
+		var source, target string
+		attrs := Attributes{}
+		for _, field := range list {
+			switch field.key {
+			case "source":
+				source = fmt.Sprint(field.value)
+			case "target":
+				target = fmt.Sprint(field.value)
+			default:
+				if _, nested := field.value.([]gmlPair); !nested {
+					attrs[field.key] = field.value
+				}
+			}
+		}
+		from, ok := nodes[source]
+		if !ok {
+			return fmt.Errorf("graph: GML edge references unknown node %q", source)
+		}
+		to, ok := nodes[target]
+		if !ok {
+			return fmt.Errorf("graph: GML edge references unknown node %q", target)
+		}
+		edge, err := mapping.parseEdge(attrs)
+		if err != nil {
+			return err
+		}
+		g.AddEdge(from, to, edge)
+	}
+	return nil
+}
+
+func writeGMLAttributes(w io.Writer, attrs Attributes, reserved ...string) error {
+	for _, name := range attrs.sortedKeys() {
+		for _, r := range reserved {
+			if name == r {
+				return fmt.Errorf("graph: GML attribute name %q is reserved", name)
+			}
+		}
+		if !gmlKeyPattern.MatchString(name) {
+			return fmt.Errorf("graph: %q is not a valid GML key", name)
+		}
+		v, err := normalizeAttribute(attrs[name])
+		if err != nil {
+			return err
+		}
+		var formatted string
+		switch v := v.(type) {
+		case string:
+			formatted = gmlQuote(v)
+		case bool:
+			formatted = "0"
+			if v {
+				formatted = "1"
+			}
+		case float64:
+			formatted = strconv.FormatFloat(v, 'g', -1, 64)
+			if !strings.ContainsAny(formatted, ".eEIN") {
+				formatted += ".0"
+			}
+		default:
+			formatted = formatAttribute(v)
+		}
+		if _, err := fmt.Fprintf(w, "    %s %s\n", name, formatted); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func gmlQuote(s string) string {
+	return `"` + html.EscapeString(s) + `"`
+}
+
+type gmlToken struct {
+	text   string
+	quoted bool
+}
+
+func tokenizeGML(input string) ([]gmlToken, error) {
+	tokens := []gmlToken{}
+	for i := 0; i < len(input); {
+		c := input[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '#':
+			for i < len(input) && input[i] != '\n' {
+				i++
+			}
+		case c == '[' || c == ']':
+			tokens = append(tokens, gmlToken{text: string(c)})
+			i++
+		case c == '"':
+			end := strings.IndexByte(input[i+1:], '"')
+			if end < 0 {
+				return nil, fmt.Errorf("graph: unterminated GML string")
+			}
+			tokens = append(tokens, gmlToken{text: html.UnescapeString(input[i+1 : i+1+end]), quoted: true})
+			i += end + 2
+		default:
+			start := i
+			for i < len(input) && !strings.ContainsRune(" \t\r\n[]\"", rune(input[i])) {
+				i++
+			}
+			tokens = append(tokens, gmlToken{text: input[start:i]})
+		}
+	}
+	return tokens, nil
+}
+
+func parseGMLList(tokens []gmlToken, pos *int, nested bool) ([]gmlPair, error) {
+	list := []gmlPair{}
+	for *pos < len(tokens) {
+		key := tokens[*pos]
+		*pos++
+		if key.text == "]" && !key.quoted {
+			if !nested {
+				return nil, fmt.Errorf("graph: unexpected ']' in GML")
+			}
+			return list, nil
+		}
+		if key.quoted || !gmlKeyPattern.MatchString(key.text) {
+			return nil, fmt.Errorf("graph: invalid GML key %q", key.text)
+		}
+		if *pos >= len(tokens) {
+			return nil, fmt.Errorf("graph: GML key %q has no value", key.text)
+		}
+		value := tokens[*pos]
+		*pos++
+		switch {
+		case value.text == "[" && !value.quoted:
+			child, err := parseGMLList(tokens, pos, true)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, gmlPair{key: key.text, value: child})
+		case value.quoted:
+			list = append(list, gmlPair{key: key.text, value: value.text})
+		default:
+			if n, err := strconv.Atoi(value.text); err == nil {
+				list = append(list, gmlPair{key: key.text, value: n})
+			} else if f, err := strconv.ParseFloat(value.text, 64); err == nil {
+				list = append(list, gmlPair{key: key.text, value: f})
+			} else {
+				return nil, fmt.Errorf("graph: invalid GML value %q for key %q", value.text, key.text)
+			}
+		}
+	}
+	if nested {
+		return nil, fmt.Errorf("graph: unterminated GML list")
+	}
+	return list, nil
+}
@@ -0,0 +1,111 @@
+package graph
+
+import (
+	"bytes"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type GMLTestSuite struct {
+	suite.Suite
+}
+
+func (s *GMLTestSuite) TestReadSampleFile() {
+	f, err := os.Open("testdata/routes.gml")
+	s.Require().NoError(err)
+	defer f.Close()
+
+	g := NewUndirectedGraph[testCity, testRoute]()
+	s.Require().NoError(ReadGML[testCity, testRoute](f, g, cityRouteMapping()))
+
+	paris := testCity{Name: "Paris", Country: "FR"}
+	lyon := testCity{Name: "Lyon", Country: "FR"}
+	geneva := testCity{Name: "Geneva", Country: "CH"}
+	s.ElementsMatch([]testCity{paris, lyon, geneva}, g.Vertices())
+	route, ok := g.Edge(geneva, lyon)
+	s.True(ok)
+	s.Equal(testRoute{Distance: 150, Mode: "road & bridge", Toll: true}, route)
+	route, _ = g.Edge(paris, lyon)
+	s.Equal(465.5, route.Distance)
+	s.False(route.Toll)
+}
+
+func (s *GMLTestSuite) TestRoundTripSampleFile() {
+	data, err := os.ReadFile("testdata/routes.gml")
+	s.Require().NoError(err)
+	original := NewUndirectedGraph[testCity, testRoute]()
+	s.Require().NoError(ReadGML[testCity, testRoute](bytes.NewReader(data), original, cityRouteMapping()))
+
+	var buf bytes.Buffer
+	s.Require().NoError(WriteGML[testCity, testRoute](&buf, original, cityRouteMapping()))
+	decoded := NewUndirectedGraph[testCity, testRoute]()
+	s.Require().NoError(ReadGML[testCity, testRoute](bytes.NewReader(buf.Bytes()), decoded, cityRouteMapping()))
+
+	s.ElementsMatch(original.Vertices(), decoded.Vertices())
+	s.Len(decoded.Edges(), len(original.Edges()))
+	for _, e := range edgeEntries[testCity, testRoute](original) {
+		route, ok := decoded.Edge(e.From, e.To)
+		s.True(ok)
+		s.Equal(e.Value, route)
+	}
+
+	var again bytes.Buffer
+	s.Require().NoError(WriteGML[testCity, testRoute](&again, decoded, cityRouteMapping()))
+	s.Equal(buf.String(), again.String())
+}
+
+func (s *GMLTestSuite) TestDirectedRoundTrip() {
+	g := NewDirectedGraph[int, float64]()
+	g.AddEdge(1, 2, 2)
+	g.AddEdge(2, 1, 0.5)
+	g.AddVertex(3)
+	mapping := AttributeMapping[int, float64]{
+		EdgeAttributes: func(w float64) Attributes { return Attributes{"weight": w} },
+		ParseNode: func(id string, _ Attributes) (int, error) {
+			return strconv.Atoi(id)
+		},
+		ParseEdge: func(attrs Attributes) (float64, error) {
+			return attrs["weight"].(float64), nil
+		},
+	}
+
+	var buf bytes.Buffer
+	s.Require().NoError(WriteGML[int, float64](&buf, g, mapping))
+	s.Contains(buf.String(), "directed 1")
+	s.Contains(buf.String(), "weight 2.0")
+
+	decoded := NewDirectedGraph[int, float64]()
+	s.Require().NoError(ReadGML[int, float64](&buf, decoded, mapping))
+	s.ElementsMatch([]int{1, 2, 3}, decoded.Vertices())
+	w, ok := decoded.Edge(1, 2)
+	s.True(ok)
+	s.Equal(2.0, w)
+	w, _ = decoded.Edge(2, 1)
+	s.Equal(0.5, w)
+}
+
+func (s *GMLTestSuite) TestErrors() {
+	mapping := AttributeMapping[string, int]{
+		ParseNode: func(id string, _ Attributes) (string, error) { return id, nil },
+	}
+	g := NewUndirectedGraph[string, int]()
+	s.Error(ReadGML[string, int](strings.NewReader(`graph [ node [ id 0 ]`), g, mapping))
+	s.Error(ReadGML[string, int](strings.NewReader(`graph [ edge [ source 0 target 1 ] ]`), g, mapping))
+	s.Error(ReadGML[string, int](strings.NewReader(`graph [ directed 1 ]`), g, mapping))
+	s.Error(ReadGML[string, int](strings.NewReader(`Creator "x"`), g, mapping))
+
+	g.AddVertex("a")
+	reserved := AttributeMapping[string, int]{
+		NodeAttributes: func(string) Attributes { return Attributes{"label": "x"} },
+	}
+	var buf bytes.Buffer
+	s.Error(WriteGML[string, int](&buf, g, reserved))
+}
+
+func TestGMLTestSuite(t *testing.T) {
+	suite.Run(t, new(GMLTestSuite))
+}
@@ -1,5 +1,48 @@
 package graph
 
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDirected is returned, possibly wrapped, by algorithms that are only
+// defined on undirected graphs when they are given a directed one.
+var ErrDirected = errors.New("graph: algorithm needs an undirected graph")
+
+func requireUndirected[N comparable, E any](g ReadOnlyGraph[N, E], algorithm string) error {
+	if g.IsDirected() {
+		return fmt.Errorf("%w: %s", ErrDirected, algorithm)
+	}
+	return nil
+}
+
+// ReadOnlyGraph is the query half of Graph. Functions that only inspect a
+// graph accept a ReadOnlyGraph, so they also work on a FrozenGraph.
+type ReadOnlyGraph[N comparable, E any] interface {
+	Neighbors(node N) []N
+	HasVertex(node N) bool
+	HasEdge(from, to N) bool
+	Edge(from, to N) (E, bool)
+	Vertices() []N
+	IsDirected() bool
+}
+
+// Graph is implemented by DirectedGraph, UndirectedGraph and GraphWrapper so
+// that serialization and algorithms can work with any of them.
+type Graph[N comparable, E any] interface {
+	ReadOnlyGraph[N, E]
+	AddVertex(node N)
+	RemoveVertex(node N)
+	AddEdge(from, to N, edge E)
+	RemoveEdge(from, to N)
+}
+
+type EdgeEntry[N comparable, E any] struct {
+	From  N
+	To    N
+	Value E
+}
+
 type genericAdjacencyListGraph[N comparable, E any] struct {
 	adj map[N]map[N]E
 }
@@ -60,6 +103,11 @@ func (g *genericAdjacencyListGraph[N, E]) hasEdge(from, to N) bool {
 	return false
 }
 
+func (g *genericAdjacencyListGraph[N, E]) edge(from, to N) (E, bool) {
+	edge, ok := g.adj[from][to]
+	return edge, ok
+}
+
 func (g *genericAdjacencyListGraph[N, E]) vertices() []N {
 	vs := make([]N, 0, len(g.adj))
 	for v := range g.adj {
@@ -84,3 +132,23 @@ func (g *genericAdjacencyListGraph[N, E]) edges() [][3]interface{} {
 	}
 	return es
 }
+
+// edgeEntries lists every edge of g once; undirected edges are reported in a
+// single direction.
+func edgeEntries[N comparable, E any](g ReadOnlyGraph[N, E]) []EdgeEntry[N, E] {
+	es := []EdgeEntry[N, E]{}
+	seen := make(map[[2]N]bool)
+	for _, from := range g.Vertices() {
+		for _, to := range g.Neighbors(from) {
+			if !g.IsDirected() {
+				if seen[[2]N{to, from}] {
+					continue
+				}
+				seen[[2]N{from, to}] = true
+			}
+			edge, _ := g.Edge(from, to)
+			es = append(es, EdgeEntry[N, E]{From: from, To: to, Value: edge})
+		}
+	}
+	return es
+}
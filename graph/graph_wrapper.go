@@ -1,7 +1,8 @@
 package graph
 
 type GraphWrapper[N comparable, E any] struct {
-	graph *genericAdjacencyListGraph[N, E]
+	graph  *genericAdjacencyListGraph[N, E]
+	events *eventHub[N, E]
 }
 
 func NewGraphWrapper[N comparable, E any]() *GraphWrapper[N, E] {
@@ -9,19 +10,27 @@ func NewGraphWrapper[N comparable, E any]() *GraphWrapper[N, E] {
 }
 
 func (gw *GraphWrapper[N, E]) AddVertex(node N) {
+	events := gw.events.vertexAddition(gw.graph, node)
 	gw.graph.addVertex(node)
+	gw.events.emit(events...)
 }
 
 func (gw *GraphWrapper[N, E]) RemoveVertex(node N) {
+	events := gw.events.vertexRemoval(gw.graph, node, false)
 	gw.graph.removeVertex(node)
+	gw.events.emit(events...)
 }
 
 func (gw *GraphWrapper[N, E]) AddEdge(from, to N, edge E) {
+	events := gw.events.edgeAddition(gw.graph, from, to, edge)
 	gw.graph.addEdge(from, to, edge)
+	gw.events.emit(events...)
 }
 
 func (gw *GraphWrapper[N, E]) RemoveEdge(from, to N) {
+	events := gw.events.edgeRemoval(gw.graph, from, to)
 	gw.graph.removeEdge(from, to)
+	gw.events.emit(events...)
 }
 
 func (gw *GraphWrapper[N, E]) Neighbors(node N) []N {
@@ -36,6 +45,14 @@ func (gw *GraphWrapper[N, E]) HasEdge(from, to N) bool {
 	return gw.graph.hasEdge(from, to)
 }
 
+func (gw *GraphWrapper[N, E]) Edge(from, to N) (E, bool) {
+	return gw.graph.edge(from, to)
+}
+
+func (gw *GraphWrapper[N, E]) IsDirected() bool {
+	return false
+}
+
 func (gw *GraphWrapper[N, E]) Vertices() []N {
 	return gw.graph.vertices()
 }
@@ -0,0 +1,308 @@
+package graph
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const graphMLNamespace = "http://graphml.graphdrawing.org/xmlns"
+
+type graphMLDocument struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr,omitempty"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID      string  `xml:"id,attr"`
+	For     string  `xml:"for,attr"`
+	Name    string  `xml:"attr.name,attr,omitempty"`
+	Type    string  `xml:"attr.type,attr,omitempty"`
+	Default *string `xml:"default"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr,omitempty"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	ID     string        `xml:"id,attr,omitempty"`
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// WriteGraphML writes g as a GraphML document that Gephi, yEd and networkx can
+// open. Nodes and edges are sorted by node ID so the output is stable.
+func WriteGraphML[N comparable, E any](w io.Writer, g ReadOnlyGraph[N, E], mapping AttributeMapping[N, E]) error {
+	doc := graphMLDocument{
+		Xmlns: graphMLNamespace,
+		Graph: graphMLGraph{ID: "G", EdgeDefault: "undirected"},
+	}
+	if g.IsDirected() {
+		doc.Graph.EdgeDefault = "directed"
+	}
+
+	nodeKeys := make(map[string]string)
+	edgeKeys := make(map[string]string)
+	nodeAttrs := make(map[string]Attributes)
+	ids := make(map[N]string)
+	nodeIDs := []string{}
+	for _, node := range g.Vertices() {
+		id := mapping.nodeID(node)
+		attrs, err := collectAttributeTypes(mapping.nodeAttributes(node), nodeKeys)
+		if err != nil {
+			return err
+		}
+		ids[node] = id
+		nodeAttrs[id] = attrs
+		nodeIDs = append(nodeIDs, id)
+	}
+	sort.Strings(nodeIDs)
+
+	edges := edgeEntries(g)
+	orderEdgeEntries(edges, ids, g.IsDirected())
+	edgeAttrs := make([]Attributes, len(edges))
+	for i, e := range edges {
+		attrs, err := collectAttributeTypes(mapping.edgeAttributes(e.Value), edgeKeys)
+		if err != nil {
+			return err
+		}
+		edgeAttrs[i] = attrs
+	}
+
+	nodeKeyIDs := appendGraphMLKeys(&doc, "node", nodeKeys)
+	edgeKeyIDs := appendGraphMLKeys(&doc, "edge", edgeKeys)
+	for _, id := range nodeIDs {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{ID: id, Data: graphMLDataFor(nodeAttrs[id], nodeKeyIDs)})
+	}
+	for i, e := range edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+			ID:     "e" + strconv.Itoa(i),
+			Source: ids[e.From],
+			Target: ids[e.To],
+			Data:   graphMLDataFor(edgeAttrs[i], edgeKeyIDs),
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// ReadGraphML adds the nodes and edges of a GraphML document to g. Keys
+// without an attr.name, such as yEd's graphics keys, are ignored.
+func ReadGraphML[N comparable, E any](r io.Reader, g Graph[N, E], mapping AttributeMapping[N, E]) error {
+	var doc graphMLDocument
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return err
+	}
+	directed := doc.Graph.EdgeDefault == "directed"
+	if directed != g.IsDirected() {
+		return fmt.Errorf("graph: cannot read edgedefault=%q GraphML into directed=%t graph", doc.Graph.EdgeDefault, g.IsDirected())
+	}
+
+	keys := make(map[string]graphMLKey)
+	for _, key := range doc.Keys {
+		if key.Name != "" {
+			keys[key.ID] = key
+		}
+	}
+
+	nodes := make(map[string]N)
+	nodeFor := func(id string, data []graphMLData) (N, error) {
+		if node, ok := nodes[id]; ok {
+			return node, nil
+		}
+		attrs, err := graphMLAttributes(keys, "node", data)
+		if err != nil {
+			return *new(N), err
+		}
+		node, err := mapping.parseNode(id, attrs)
+		if err != nil {
+			return node, err
+		}
+		nodes[id] = node
+		g.AddVertex(node)
+		return node, nil
+	}
+
+	for _, n := range doc.Graph.Nodes {
+		if _, err := nodeFor(n.ID, n.Data); err != nil {
+			return err
+		}
+	}
+	for _, e := range doc.Graph.Edges {
+		from, err := nodeFor(e.Source, nil)
+		if err != nil {
+			return err
+		}
+		to, err := nodeFor(e.Target, nil)
+		if err != nil {
+			return err
+		}
+		attrs, err := graphMLAttributes(keys, "edge", e.Data)
+		if err != nil {
+			return err
+		}
+		edge, err := mapping.parseEdge(attrs)
+		if err != nil {
+			return err
+		}
+		g.AddEdge(from, to, edge)
+	}
+	return nil
+}
+
+// collectAttributeTypes normalizes attrs and records the type of each key,
+// failing when a key is used with two different types.
+func collectAttributeTypes(attrs Attributes, types map[string]string) (Attributes, error) {
+	normalized := make(Attributes, len(attrs))
+	for name, value := range attrs {
+		v, err := normalizeAttribute(value)
+		if err != nil {
+			return nil, err
+		}
+		typ := graphMLType(v)
+		if existing, ok := types[name]; ok && existing != typ {
+			return nil, fmt.Errorf("graph: attribute %q used as both %s and %s", name, existing, typ)
+		}
+		types[name] = typ
+		normalized[name] = v
+	}
+	return normalized, nil
+}
+
+func appendGraphMLKeys(doc *graphMLDocument, domain string, types map[string]string) map[string]string {
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	keyIDs := make(map[string]string)
+	for _, name := range names {
+		id := "d" + strconv.Itoa(len(doc.Keys))
+		keyIDs[name] = id
+		doc.Keys = append(doc.Keys, graphMLKey{ID: id, For: domain, Name: name, Type: types[name]})
+	}
+	return keyIDs
+}
+
+func graphMLDataFor(attrs Attributes, keyIDs map[string]string) []graphMLData {
+	data := []graphMLData{}
+	for _, name := range attrs.sortedKeys() {
+		data = append(data, graphMLData{Key: keyIDs[name], Value: formatAttribute(attrs[name])})
+	}
+	return data
+}
+
+func graphMLAttributes(keys map[string]graphMLKey, domain string, data []graphMLData) (Attributes, error) {
+	attrs := Attributes{}
+	for _, key := range keys {
+		if key.Default != nil && (key.For == domain || key.For == "all") {
+			v, err := parseGraphMLValue(key.Type, *key.Default)
+			if err != nil {
+				return nil, err
+			}
+			attrs[key.Name] = v
+		}
+	}
+	for _, d := range data {
+		key, ok := keys[d.Key]
+		if !ok {
+			continue
+		}
+		if key.For != "" && key.For != domain && key.For != "all" {
+			return nil, fmt.Errorf("graph: GraphML key %q is declared for %s but used on a %s", key.ID, key.For, domain)
+		}
+		v, err := parseGraphMLValue(key.Type, d.Value)
+		if err != nil {
+			return nil, fmt.Errorf("graph: GraphML key %q: %w", key.Name, err)
+		}
+		attrs[key.Name] = v
+	}
+	return attrs, nil
+}
+
+func graphMLType(value any) string {
+	switch value.(type) {
+	case bool:
+		return "boolean"
+	case int:
+		return "long"
+	case float64:
+		return "double"
+	default:
+		return "string"
+	}
+}
+
+func parseGraphMLValue(typ, raw string) (any, error) {
+	switch typ {
+	case "boolean":
+		return strconv.ParseBool(strings.TrimSpace(raw))
+	case "int", "long":
+		return strconv.Atoi(strings.TrimSpace(raw))
+	case "float", "double":
+		return strconv.ParseFloat(strings.TrimSpace(raw), 64)
+	default:
+		return raw, nil
+	}
+}
+
+func formatAttribute(value any) string {
+	switch v := value.(type) {
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// orderEdgeEntries sorts edges by source and target ID so that writers produce
+// the same file for the same graph. Undirected edges are first flipped to run
+// from the smaller ID.
+func orderEdgeEntries[N comparable, E any](edges []EdgeEntry[N, E], ids map[N]string, directed bool) {
+	if !directed {
+		for i, e := range edges {
+			if ids[e.From] > ids[e.To] {
+				edges[i].From, edges[i].To = e.To, e.From
+			}
+		}
+	}
+	sort.SliceStable(edges, func(i, j int) bool {
+		if ids[edges[i].From] != ids[edges[j].From] {
+			return ids[edges[i].From] < ids[edges[j].From]
+		}
+		return ids[edges[i].To] < ids[edges[j].To]
+	})
+}
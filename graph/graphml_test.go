@@ -0,0 +1,243 @@
+package graph
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type testCity struct {
+	Name    string
+	Country string
+}
+
+type testRoute struct {
+	Distance float64
+	Mode     string
+	Toll     bool
+}
+
+func cityRouteMapping() AttributeMapping[testCity, testRoute] {
+	return AttributeMapping[testCity, testRoute]{
+		NodeID: func(c testCity) string { return c.Name },
+		NodeAttributes: func(c testCity) Attributes {
+			return Attributes{"country": c.Country}
+		},
+		EdgeAttributes: func(r testRoute) Attributes {
+			return Attributes{"distance": r.Distance, "mode": r.Mode, "toll": r.Toll}
+		},
+		ParseNode: func(id string, attrs Attributes) (testCity, error) {
+			country, _ := attrs["country"].(string)
+			return testCity{Name: id, Country: country}, nil
+		},
+		ParseEdge: func(attrs Attributes) (testRoute, error) {
+			r := testRoute{}
+			switch d := attrs["distance"].(type) {
+			case float64:
+				r.Distance = d
+			case int:
+				r.Distance = float64(d)
+			default:
+				return r, fmt.Errorf("missing distance")
+			}
+			r.Mode, _ = attrs["mode"].(string)
+			switch t := attrs["toll"].(type) {
+			case bool:
+				r.Toll = t
+			case int:
+				r.Toll = t != 0
+			}
+			return r, nil
+		},
+	}
+}
+
+type GraphMLTestSuite struct {
+	suite.Suite
+}
+
+func (s *GraphMLTestSuite) TestReadSampleFile() {
+	f, err := os.Open("testdata/routes.graphml")
+	s.Require().NoError(err)
+	defer f.Close()
+
+	g := NewUndirectedGraph[testCity, testRoute]()
+	s.Require().NoError(ReadGraphML[testCity, testRoute](f, g, cityRouteMapping()))
+
+	paris := testCity{Name: "Paris", Country: "FR"}
+	lyon := testCity{Name: "Lyon", Country: "FR"}
+	geneva := testCity{Name: "Geneva", Country: "CH"}
+	s.Len(g.Vertices(), 4)
+	s.True(g.HasVertex(geneva))
+
+	route, ok := g.Edge(lyon, paris)
+	s.True(ok)
+	s.Equal(testRoute{Distance: 465.5, Mode: "rail", Toll: false}, route)
+	route, ok = g.Edge(geneva, lyon)
+	s.True(ok)
+	s.True(route.Toll)
+}
+
+func (s *GraphMLTestSuite) TestRoundTripSampleFile() {
+	data, err := os.ReadFile("testdata/routes.graphml")
+	s.Require().NoError(err)
+	original := NewUndirectedGraph[testCity, testRoute]()
+	s.Require().NoError(ReadGraphML[testCity, testRoute](bytes.NewReader(data), original, cityRouteMapping()))
+
+	var buf bytes.Buffer
+	s.Require().NoError(WriteGraphML[testCity, testRoute](&buf, original, cityRouteMapping()))
+	decoded := NewUndirectedGraph[testCity, testRoute]()
+	s.Require().NoError(ReadGraphML[testCity, testRoute](bytes.NewReader(buf.Bytes()), decoded, cityRouteMapping()))
+
+	s.ElementsMatch(original.Vertices(), decoded.Vertices())
+	s.Len(decoded.Edges(), len(original.Edges()))
+	for _, e := range edgeEntries[testCity, testRoute](original) {
+		route, ok := decoded.Edge(e.From, e.To)
+		s.True(ok)
+		s.Equal(e.Value, route)
+	}
+
+	var again bytes.Buffer
+	s.Require().NoError(WriteGraphML[testCity, testRoute](&again, decoded, cityRouteMapping()))
+	s.Equal(buf.String(), again.String())
+}
+
+func (s *GraphMLTestSuite) TestReadYEdFile() {
+	f, err := os.Open("testdata/workflow_yed.graphml")
+	s.Require().NoError(err)
+	defer f.Close()
+
+	owners := map[string]string{}
+	mapping := AttributeMapping[string, int]{
+		ParseNode: func(id string, attrs Attributes) (string, error) {
+			owners[id], _ = attrs["owner"].(string)
+			return id, nil
+		},
+		ParseEdge: func(attrs Attributes) (int, error) {
+			w, _ := attrs["weight"].(int)
+			return w, nil
+		},
+	}
+	g := NewDirectedGraph[string, int]()
+	s.Require().NoError(ReadGraphML[string, int](f, g, mapping))
+	s.ElementsMatch([]string{"build", "test", "deploy"}, g.Vertices())
+	s.Equal("ops", owners["deploy"])
+	w, ok := g.Edge("build", "test")
+	s.True(ok)
+	s.Equal(2, w)
+	s.False(g.HasEdge("test", "build"))
+}
+
+func (s *GraphMLTestSuite) TestErrors() {
+	f, err := os.Open("testdata/routes.graphml")
+	s.Require().NoError(err)
+	defer f.Close()
+	s.Error(ReadGraphML[testCity, testRoute](f, NewDirectedGraph[testCity, testRoute](), cityRouteMapping()))
+
+	g := NewDirectedGraph[int, int]()
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 2)
+	mixed := AttributeMapping[int, int]{
+		EdgeAttributes: func(e int) Attributes {
+			if e == 1 {
+				return Attributes{"w": 1}
+			}
+			return Attributes{"w": "two"}
+		},
+	}
+	var buf bytes.Buffer
+	s.Error(WriteGraphML[int, int](&buf, g, mixed))
+	s.Error(ReadGraphML[int, int](bytes.NewReader([]byte(`<graphml><graph edgedefault="directed"><node id="1"/></graph></graphml>`)), g, AttributeMapping[int, int]{}))
+}
+
+func (s *GraphMLTestSuite) TestUnsignedAttributesRoundTrip() {
+	g := NewDirectedGraph[int, uint64]()
+	g.AddEdge(1, 2, 1<<40)
+	g.AddEdge(2, 3, 7)
+	mapping := AttributeMapping[int, uint64]{
+		NodeAttributes: func(n int) Attributes { return Attributes{"rank": uint(n * 10)} },
+		EdgeAttributes: func(e uint64) Attributes { return Attributes{"bytes": e} },
+		ParseNode: func(id string, attrs Attributes) (int, error) {
+			rank, ok := AttributeValue[int](attrs, "rank")
+			if !ok {
+				return 0, fmt.Errorf("node %s: missing rank", id)
+			}
+			return rank / 10, nil
+		},
+		ParseEdge: func(attrs Attributes) (uint64, error) {
+			b, _ := AttributeValue[int](attrs, "bytes")
+			return uint64(b), nil
+		},
+	}
+	var buf bytes.Buffer
+	s.Require().NoError(WriteGraphML[int, uint64](&buf, g, mapping))
+	s.Contains(buf.String(), `attr.type="long"`)
+	decoded := NewDirectedGraph[int, uint64]()
+	s.Require().NoError(ReadGraphML[int, uint64](bytes.NewReader(buf.Bytes()), decoded, mapping))
+	s.ElementsMatch([]int{1, 2, 3}, decoded.Vertices())
+	w, ok := decoded.Edge(1, 2)
+	s.True(ok)
+	s.Equal(uint64(1<<40), w)
+	w, _ = decoded.Edge(2, 3)
+	s.Equal(uint64(7), w)
+
+	overflow := AttributeMapping[int, uint64]{
+		EdgeAttributes: func(e uint64) Attributes { return Attributes{"bytes": uint64(1 << 63)} },
+	}
+	s.Error(WriteGraphML[int, uint64](&buf, g, overflow))
+}
+
+func (s *GraphMLTestSuite) TestDataKeyDomainIsChecked() {
+	mapping := AttributeMapping[string, int]{
+		ParseNode: func(id string, attrs Attributes) (string, error) { return id, nil },
+		ParseEdge: func(attrs Attributes) (int, error) {
+			w, _ := AttributeValue[int](attrs, "weight")
+			return w, nil
+		},
+	}
+	read := func(doc string) error {
+		return ReadGraphML[string, int](bytes.NewReader([]byte(doc)), NewDirectedGraph[string, int](), mapping)
+	}
+	s.NoError(read(`<graphml>
+  <key id="w" for="edge" attr.name="weight" attr.type="int"/>
+  <key id="any" for="all" attr.name="note" attr.type="string"/>
+  <graph edgedefault="directed">
+    <node id="a"><data key="any">x</data></node>
+    <node id="b"/>
+    <edge source="a" target="b"><data key="w">3</data><data key="any">y</data></edge>
+  </graph>
+</graphml>`))
+	s.Error(read(`<graphml>
+  <key id="w" for="edge" attr.name="weight" attr.type="int"/>
+  <graph edgedefault="directed">
+    <node id="a"><data key="w">3</data></node>
+  </graph>
+</graphml>`))
+	s.Error(read(`<graphml>
+  <key id="label" for="node" attr.name="label" attr.type="string"/>
+  <graph edgedefault="directed">
+    <node id="a"/><node id="b"/>
+    <edge source="a" target="b"><data key="label">oops</data></edge>
+  </graph>
+</graphml>`))
+
+	// A file written by WriteGraphML always passes the check.
+	g := NewDirectedGraph[string, int]()
+	g.AddEdge("a", "b", 5)
+	var buf bytes.Buffer
+	s.Require().NoError(WriteGraphML[string, int](&buf, g, AttributeMapping[string, int]{
+		NodeAttributes: func(n string) Attributes { return Attributes{"weight": len(n)} },
+		EdgeAttributes: func(e int) Attributes { return Attributes{"weight": e} },
+	}))
+	decoded := NewDirectedGraph[string, int]()
+	s.Require().NoError(ReadGraphML[string, int](bytes.NewReader(buf.Bytes()), decoded, mapping))
+	w, _ := decoded.Edge("a", "b")
+	s.Equal(5, w)
+}
+
+func TestGraphMLTestSuite(t *testing.T) {
+	suite.Run(t, new(GraphMLTestSuite))
+}
@@ -0,0 +1,55 @@
+package graph
+
+import "fmt"
+
+// MaxHamiltonianVertices bounds the graphs HamiltonianPath will search, since
+// backtracking takes exponential time.
+const MaxHamiltonianVertices = 20
+
+// HamiltonianPath searches for a path that visits every vertex exactly once by
+// backtracking, trying low-degree vertices first. It returns ErrNoPath when
+// there is none and refuses graphs with more than MaxHamiltonianVertices
+// vertices.
+func HamiltonianPath[N comparable, E any](g ReadOnlyGraph[N, E]) ([]N, error) {
+	ig := newIndexedGraph[N, E](g)
+	n := ig.size()
+	if n > MaxHamiltonianVertices {
+		return nil, fmt.Errorf("graph: Hamiltonian path search supports at most %d vertices, got %d", MaxHamiltonianVertices, n)
+	}
+	if n == 0 {
+		return []N{}, nil
+	}
+	order := verticesByDegree(ig)
+	for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+		order[i], order[j] = order[j], order[i]
+	}
+
+	visited := make([]bool, n)
+	path := make([]int, 0, n)
+	var extend func(v int) bool
+	extend = func(v int) bool {
+		visited[v] = true
+		path = append(path, v)
+		if len(path) == n {
+			return true
+		}
+		for _, u := range ig.adj[v] {
+			if !visited[u] && extend(u) {
+				return true
+			}
+		}
+		visited[v] = false
+		path = path[:len(path)-1]
+		return false
+	}
+	for _, start := range order {
+		if extend(start) {
+			nodes := make([]N, n)
+			for i, v := range path {
+				nodes[i] = ig.nodes[v]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: graph has no Hamiltonian path", ErrNoPath)
+}
@@ -0,0 +1,61 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type HamiltonTestSuite struct {
+	suite.Suite
+}
+
+func (s *HamiltonTestSuite) isHamiltonianPath(g Graph[int, struct{}], path []int) {
+	s.Len(path, len(g.Vertices()))
+	seen := map[int]bool{}
+	for i, v := range path {
+		s.False(seen[v], "vertex %d repeated", v)
+		seen[v] = true
+		if i > 0 {
+			s.True(g.HasEdge(path[i-1], v))
+		}
+	}
+}
+
+func (s *HamiltonTestSuite) TestFindsPath() {
+	g := undirectedFromEdges(petersenEdges())
+	path, err := HamiltonianPath[int, struct{}](g)
+	s.NoError(err)
+	s.isHamiltonianPath(g, path)
+
+	d := NewDirectedGraph[int, struct{}]()
+	for _, e := range [][2]int{{3, 1}, {1, 2}, {2, 0}, {0, 4}, {1, 4}} {
+		d.AddEdge(e[0], e[1], struct{}{})
+	}
+	path, err = HamiltonianPath[int, struct{}](d)
+	s.NoError(err)
+	s.Equal([]int{3, 1, 2, 0, 4}, path)
+}
+
+func (s *HamiltonTestSuite) TestNoPath() {
+	// A star with three leaves has no Hamiltonian path.
+	g := undirectedFromEdges([][2]int{{0, 1}, {0, 2}, {0, 3}})
+	_, err := HamiltonianPath[int, struct{}](g)
+	s.True(errors.Is(err, ErrNoPath))
+
+	path, err := HamiltonianPath[int, struct{}](NewUndirectedGraph[int, struct{}]())
+	s.NoError(err)
+	s.Empty(path)
+}
+
+func (s *HamiltonTestSuite) TestSizeGuard() {
+	g := undirectedFromEdges(cycleEdges(MaxHamiltonianVertices + 1))
+	_, err := HamiltonianPath[int, struct{}](g)
+	s.Error(err)
+	s.False(errors.Is(err, ErrNoPath))
+}
+
+func TestHamiltonTestSuite(t *testing.T) {
+	suite.Run(t, new(HamiltonTestSuite))
+}
@@ -0,0 +1,83 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+)
+
+// indexedGraph is a snapshot of a Graph with vertices numbered 0..n-1, used by
+// algorithms that are simpler or faster on integer indexes than on N keys.
+// Vertices keep the order in which Vertices() returned them. Algorithms must
+// treat it as read-only, since a FrozenGraph hands out one shared view.
+type indexedGraph[N comparable] struct {
+	nodes  []N
+	index  map[N]int
+	adj    [][]int
+	adjSet []map[int]bool
+}
+
+// indexedViewer is implemented by read-only graphs that keep a prebuilt
+// indexedGraph in sorted vertex order, such as FrozenGraph.
+type indexedViewer[N comparable] interface {
+	indexedView() *indexedGraph[N]
+}
+
+func newIndexedGraph[N comparable, E any](g ReadOnlyGraph[N, E]) *indexedGraph[N] {
+	if v, ok := g.(indexedViewer[N]); ok {
+		return v.indexedView()
+	}
+	return indexNodes(g, g.Vertices())
+}
+
+// newSortedIndexedGraph numbers vertices in the order of their %v formatting,
+// so that seeded randomized algorithms give the same answer on every run.
+func newSortedIndexedGraph[N comparable, E any](g ReadOnlyGraph[N, E]) *indexedGraph[N] {
+	if v, ok := g.(indexedViewer[N]); ok {
+		return v.indexedView()
+	}
+	nodes := g.Vertices()
+	keys := make(map[N]string, len(nodes))
+	for _, node := range nodes {
+		keys[node] = fmt.Sprintf("%v", node)
+	}
+	sort.SliceStable(nodes, func(i, j int) bool { return keys[nodes[i]] < keys[nodes[j]] })
+	return indexNodes(g, nodes)
+}
+
+func indexNodes[N comparable, E any](g ReadOnlyGraph[N, E], nodes []N) *indexedGraph[N] {
+	ig := &indexedGraph[N]{
+		nodes:  nodes,
+		index:  make(map[N]int, len(nodes)),
+		adj:    make([][]int, len(nodes)),
+		adjSet: make([]map[int]bool, len(nodes)),
+	}
+	for i, node := range nodes {
+		ig.index[node] = i
+	}
+	for i, node := range nodes {
+		ig.adjSet[i] = make(map[int]bool)
+		for _, neighbor := range g.Neighbors(node) {
+			j := ig.index[neighbor]
+			ig.adj[i] = append(ig.adj[i], j)
+			ig.adjSet[i][j] = true
+		}
+		sort.Ints(ig.adj[i])
+	}
+	return ig
+}
+
+func (ig *indexedGraph[N]) size() int {
+	return len(ig.nodes)
+}
+
+func (ig *indexedGraph[N]) hasEdge(i, j int) bool {
+	return ig.adjSet[i][j]
+}
+
+// degree ignores self-loops.
+func (ig *indexedGraph[N]) degree(i int) int {
+	if ig.adjSet[i][i] {
+		return len(ig.adj[i]) - 1
+	}
+	return len(ig.adj[i])
+}
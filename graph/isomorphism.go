@@ -0,0 +1,367 @@
+package graph
+
+import "iter"
+
+// MatchOptions narrows which vertices and edges may be paired by the
+// isomorphism functions. Nil predicates accept every pair.
+type MatchOptions[N comparable, E any] struct {
+	NodeMatch func(a, b N) bool
+	EdgeMatch func(a, b E) bool
+}
+
+type vf2Mode int
+
+const (
+	vf2Isomorphism vf2Mode = iota
+	vf2InducedSubgraph
+	vf2Monomorphism
+)
+
+// IsIsomorphic reports whether g1 and g2 have the same structure, and the same
+// directedness, under the given options.
+func IsIsomorphic[N comparable, E any](g1, g2 ReadOnlyGraph[N, E], opts MatchOptions[N, E]) bool {
+	for range Isomorphisms(g1, g2, opts) {
+		return true
+	}
+	return false
+}
+
+// Isomorphisms yields every bijection from the vertices of g1 to those of g2
+// that preserves edges, found with the VF2 algorithm.
+func Isomorphisms[N comparable, E any](g1, g2 ReadOnlyGraph[N, E], opts MatchOptions[N, E]) iter.Seq[map[N]N] {
+	return func(yield func(map[N]N) bool) {
+		m := newVF2Matcher(g1, g2, opts, vf2Isomorphism)
+		if m == nil {
+			return
+		}
+		m.match(func() bool { return yield(m.mapping(false)) })
+	}
+}
+
+// IsSubgraphIsomorphic reports whether pattern occurs in g as an induced
+// subgraph.
+func IsSubgraphIsomorphic[N comparable, E any](g, pattern ReadOnlyGraph[N, E], opts MatchOptions[N, E]) bool {
+	for range SubgraphIsomorphisms(g, pattern, opts) {
+		return true
+	}
+	return false
+}
+
+// SubgraphIsomorphisms yields every mapping from the vertices of pattern into
+// g under which two pattern vertices are adjacent exactly when their images
+// are, i.e. occurrences of pattern as an induced subgraph of g.
+func SubgraphIsomorphisms[N comparable, E any](g, pattern ReadOnlyGraph[N, E], opts MatchOptions[N, E]) iter.Seq[map[N]N] {
+	return subgraphMatches(g, pattern, opts, vf2InducedSubgraph)
+}
+
+// SubgraphMonomorphisms is like SubgraphIsomorphisms but g may have extra
+// edges between the matched vertices.
+func SubgraphMonomorphisms[N comparable, E any](g, pattern ReadOnlyGraph[N, E], opts MatchOptions[N, E]) iter.Seq[map[N]N] {
+	return subgraphMatches(g, pattern, opts, vf2Monomorphism)
+}
+
+func subgraphMatches[N comparable, E any](g, pattern ReadOnlyGraph[N, E], opts MatchOptions[N, E], mode vf2Mode) iter.Seq[map[N]N] {
+	return func(yield func(map[N]N) bool) {
+		m := newVF2Matcher(g, pattern, opts, mode)
+		if m == nil {
+			return
+		}
+		m.match(func() bool { return yield(m.mapping(true)) })
+	}
+}
+
+type vf2Graph struct {
+	n       int
+	succ    [][]int
+	pred    [][]int
+	succSet []map[int]bool
+	predSet []map[int]bool
+	loops   []bool
+	edges   int
+}
+
+func newVF2Graph[N comparable](ig *indexedGraph[N], directed bool) vf2Graph {
+	vg := vf2Graph{
+		n:       ig.size(),
+		succ:    ig.adj,
+		succSet: ig.adjSet,
+		loops:   make([]bool, ig.size()),
+	}
+	for i, neighbors := range ig.adj {
+		vg.loops[i] = ig.adjSet[i][i]
+		vg.edges += len(neighbors)
+	}
+	if !directed {
+		vg.pred, vg.predSet = vg.succ, vg.succSet
+		return vg
+	}
+	vg.pred = make([][]int, vg.n)
+	vg.predSet = make([]map[int]bool, vg.n)
+	for i := range vg.predSet {
+		vg.predSet[i] = make(map[int]bool)
+	}
+	for i, neighbors := range ig.adj {
+		for _, j := range neighbors {
+			vg.pred[j] = append(vg.pred[j], i)
+			vg.predSet[j][i] = true
+		}
+	}
+	return vg
+}
+
+// vf2Matcher maps vertices of graph a (the larger graph) to vertices of graph
+// b (the pattern). The in and out slices record the search depth at which a
+// vertex joined the terminal sets, or 0 if it has not.
+type vf2Matcher[N comparable, E any] struct {
+	g1, g2       ReadOnlyGraph[N, E]
+	ig1, ig2     *indexedGraph[N]
+	a, b         vf2Graph
+	directed     bool
+	mode         vf2Mode
+	opts         MatchOptions[N, E]
+	core1, core2 []int
+	in1, out1    []int
+	in2, out2    []int
+	depth        int
+}
+
+func newVF2Matcher[N comparable, E any](g1, g2 ReadOnlyGraph[N, E], opts MatchOptions[N, E], mode vf2Mode) *vf2Matcher[N, E] {
+	if g1.IsDirected() != g2.IsDirected() {
+		return nil
+	}
+	directed := g1.IsDirected()
+	ig1, ig2 := newIndexedGraph[N, E](g1), newIndexedGraph[N, E](g2)
+	m := &vf2Matcher[N, E]{
+		g1: g1, g2: g2, ig1: ig1, ig2: ig2,
+		a: newVF2Graph(ig1, directed), b: newVF2Graph(ig2, directed),
+		directed: directed, mode: mode, opts: opts,
+	}
+	if m.b.n > m.a.n || m.b.edges > m.a.edges {
+		return nil
+	}
+	if mode == vf2Isomorphism && (m.a.n != m.b.n || m.a.edges != m.b.edges) {
+		return nil
+	}
+	m.core1, m.in1, m.out1 = filled(m.a.n, -1), make([]int, m.a.n), make([]int, m.a.n)
+	m.core2, m.in2, m.out2 = filled(m.b.n, -1), make([]int, m.b.n), make([]int, m.b.n)
+	return m
+}
+
+func filled(n, value int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = value
+	}
+	return s
+}
+
+// match calls found for every complete mapping and stops when found returns
+// false. It reports whether the search should continue.
+func (m *vf2Matcher[N, E]) match(found func() bool) bool {
+	if m.depth == m.b.n {
+		return found()
+	}
+	for _, pair := range m.candidates() {
+		if !m.feasible(pair[0], pair[1]) {
+			continue
+		}
+		m.push(pair[0], pair[1])
+		more := m.match(found)
+		m.pop(pair[0], pair[1])
+		if !more {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *vf2Matcher[N, E]) candidates() [][2]int {
+	// pairs matches the first unmapped vertex of terminal2 against every
+	// unmapped vertex of terminal1. It reports false when terminal2 has no
+	// unmapped vertex; otherwise an empty result means the branch is dead,
+	// since that vertex must map into terminal1.
+	pairs := func(terminal1, terminal2 []int, core1, core2 []int) ([][2]int, bool) {
+		target := -1
+		for j, d := range terminal2 {
+			if d > 0 && core2[j] == -1 {
+				target = j
+				break
+			}
+		}
+		if target == -1 {
+			return nil, false
+		}
+		ps := [][2]int{}
+		for i, d := range terminal1 {
+			if d > 0 && core1[i] == -1 {
+				ps = append(ps, [2]int{i, target})
+			}
+		}
+		return ps, true
+	}
+	if ps, ok := pairs(m.out1, m.out2, m.core1, m.core2); ok {
+		return ps
+	}
+	if m.directed {
+		if ps, ok := pairs(m.in1, m.in2, m.core1, m.core2); ok {
+			return ps
+		}
+	}
+	target := -1
+	for j, c := range m.core2 {
+		if c == -1 {
+			target = j
+			break
+		}
+	}
+	ps := [][2]int{}
+	for i, c := range m.core1 {
+		if c == -1 {
+			ps = append(ps, [2]int{i, target})
+		}
+	}
+	return ps
+}
+
+func (m *vf2Matcher[N, E]) feasible(n1, n2 int) bool {
+	if m.opts.NodeMatch != nil && !m.opts.NodeMatch(m.ig1.nodes[n1], m.ig2.nodes[n2]) {
+		return false
+	}
+	loop1, loop2 := m.a.loops[n1], m.b.loops[n2]
+	if loop2 && !loop1 || (m.mode != vf2Monomorphism && loop1 != loop2) {
+		return false
+	}
+	if loop2 && !m.edgesMatch(n1, n1, n2, n2) {
+		return false
+	}
+	if !m.consistent(n1, n2, m.a.succ, m.b.succ, m.a.succSet, m.b.succSet, false) {
+		return false
+	}
+	if m.directed && !m.consistent(n1, n2, m.a.pred, m.b.pred, m.a.predSet, m.b.predSet, true) {
+		return false
+	}
+	if m.mode == vf2Monomorphism {
+		return len(m.a.succ[n1]) >= len(m.b.succ[n2]) && len(m.a.pred[n1]) >= len(m.b.pred[n2])
+	}
+	if !m.lookAhead(m.a.succ[n1], m.b.succ[n2], n1, n2) {
+		return false
+	}
+	return !m.directed || m.lookAhead(m.a.pred[n1], m.b.pred[n2], n1, n2)
+}
+
+// consistent checks the edges between the candidate pair and the vertices
+// already mapped. reversed marks predecessor lists, whose edges point into n1
+// and n2.
+func (m *vf2Matcher[N, E]) consistent(n1, n2 int, adj1, adj2 [][]int, set1, set2 []map[int]bool, reversed bool) bool {
+	if m.mode != vf2Monomorphism {
+		for _, s := range adj1[n1] {
+			if s != n1 && m.core1[s] != -1 && !set2[n2][m.core1[s]] {
+				return false
+			}
+		}
+	}
+	for _, s := range adj2[n2] {
+		if s == n2 || m.core2[s] == -1 {
+			continue
+		}
+		t := m.core2[s]
+		if !set1[n1][t] {
+			return false
+		}
+		if reversed {
+			if !m.edgesMatch(t, n1, s, n2) {
+				return false
+			}
+		} else if !m.edgesMatch(n1, t, n2, s) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *vf2Matcher[N, E]) edgesMatch(from1, to1, from2, to2 int) bool {
+	if m.opts.EdgeMatch == nil {
+		return true
+	}
+	e1, _ := m.g1.Edge(m.ig1.nodes[from1], m.ig1.nodes[to1])
+	e2, _ := m.g2.Edge(m.ig2.nodes[from2], m.ig2.nodes[to2])
+	return m.opts.EdgeMatch(e1, e2)
+}
+
+// lookAhead compares how many unmapped neighbours of each candidate lie in the
+// terminal sets and outside them. Isomorphism needs equal counts; an induced
+// subgraph needs g to have at least as many as the pattern.
+func (m *vf2Matcher[N, E]) lookAhead(adj1, adj2 []int, n1, n2 int) bool {
+	count := func(adj []int, self int, core, in, out []int) [3]int {
+		var c [3]int
+		for _, v := range adj {
+			if v == self || core[v] != -1 {
+				continue
+			}
+			if in[v] > 0 {
+				c[0]++
+			}
+			if out[v] > 0 {
+				c[1]++
+			}
+			if in[v] == 0 && out[v] == 0 {
+				c[2]++
+			}
+		}
+		return c
+	}
+	c1 := count(adj1, n1, m.core1, m.in1, m.out1)
+	c2 := count(adj2, n2, m.core2, m.in2, m.out2)
+	for i := range c1 {
+		if m.mode == vf2Isomorphism && c1[i] != c2[i] || c1[i] < c2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *vf2Matcher[N, E]) push(n1, n2 int) {
+	m.depth++
+	m.core1[n1], m.core2[n2] = n2, n1
+	enter := func(terminal []int, vertices ...[]int) {
+		for _, vs := range vertices {
+			for _, v := range vs {
+				if terminal[v] == 0 {
+					terminal[v] = m.depth
+				}
+			}
+		}
+	}
+	enter(m.out1, []int{n1}, m.a.succ[n1])
+	enter(m.out2, []int{n2}, m.b.succ[n2])
+	if m.directed {
+		enter(m.in1, []int{n1}, m.a.pred[n1])
+		enter(m.in2, []int{n2}, m.b.pred[n2])
+	}
+}
+
+func (m *vf2Matcher[N, E]) pop(n1, n2 int) {
+	for _, terminal := range [][]int{m.in1, m.out1, m.in2, m.out2} {
+		for v, d := range terminal {
+			if d == m.depth {
+				terminal[v] = 0
+			}
+		}
+	}
+	m.core1[n1], m.core2[n2] = -1, -1
+	m.depth--
+}
+
+// mapping returns the current match from g1 to g2, or from the pattern g2 to
+// g1 when fromPattern is set.
+func (m *vf2Matcher[N, E]) mapping(fromPattern bool) map[N]N {
+	result := make(map[N]N, m.b.n)
+	for j, i := range m.core2 {
+		if fromPattern {
+			result[m.ig2.nodes[j]] = m.ig1.nodes[i]
+		} else {
+			result[m.ig1.nodes[i]] = m.ig2.nodes[j]
+		}
+	}
+	return result
+}
@@ -0,0 +1,197 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type IsomorphismTestSuite struct {
+	suite.Suite
+}
+
+func directedFromEdges(edges [][2]int) *DirectedGraph[int, struct{}] {
+	g := NewDirectedGraph[int, struct{}]()
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1], struct{}{})
+	}
+	return g
+}
+
+func relabel(edges [][2]int, offset int) [][2]int {
+	out := make([][2]int, len(edges))
+	for i, e := range edges {
+		out[i] = [2]int{(e[0]*7+3)%10 + offset, (e[1]*7+3)%10 + offset}
+	}
+	return out
+}
+
+func (s *IsomorphismTestSuite) assertPreservesEdges(from, to Graph[int, struct{}], mapping map[int]int) {
+	for _, e := range edgeEntries(from) {
+		s.True(to.HasEdge(mapping[e.From], mapping[e.To]), "edge %v-%v", e.From, e.To)
+	}
+}
+
+func (s *IsomorphismTestSuite) TestIsIsomorphic() {
+	s.Run("relabelled Petersen graph", func() {
+		g1 := undirectedFromEdges(petersenEdges())
+		g2 := undirectedFromEdges(relabel(petersenEdges(), 100))
+		s.True(IsIsomorphic[int, struct{}](g1, g2, MatchOptions[int, struct{}]{}))
+	})
+
+	s.Run("same degree sequence but different structure", func() {
+		// Two triangles versus a hexagon: both 2-regular on six vertices.
+		triangles := undirectedFromEdges([][2]int{{0, 1}, {1, 2}, {2, 0}, {3, 4}, {4, 5}, {5, 3}})
+		hexagon := undirectedFromEdges(cycleEdges(6))
+		s.False(IsIsomorphic[int, struct{}](triangles, hexagon, MatchOptions[int, struct{}]{}))
+	})
+
+	s.Run("edge direction matters", func() {
+		path := directedFromEdges([][2]int{{0, 1}, {1, 2}})
+		fork := directedFromEdges([][2]int{{0, 1}, {0, 2}})
+		reversed := directedFromEdges([][2]int{{2, 1}, {1, 0}})
+		s.False(IsIsomorphic[int, struct{}](path, fork, MatchOptions[int, struct{}]{}))
+		s.True(IsIsomorphic[int, struct{}](path, reversed, MatchOptions[int, struct{}]{}))
+	})
+
+	s.Run("directed and undirected never match", func() {
+		s.False(IsIsomorphic[int, struct{}](directedFromEdges([][2]int{{0, 1}}), undirectedFromEdges([][2]int{{0, 1}}), MatchOptions[int, struct{}]{}))
+	})
+
+	s.Run("self-loops must line up", func() {
+		g1 := undirectedFromEdges([][2]int{{0, 1}, {1, 1}})
+		g2 := undirectedFromEdges([][2]int{{0, 1}, {0, 0}})
+		g3 := undirectedFromEdges([][2]int{{0, 1}, {0, 2}})
+		s.True(IsIsomorphic[int, struct{}](g1, g2, MatchOptions[int, struct{}]{}))
+		s.False(IsIsomorphic[int, struct{}](g1, g3, MatchOptions[int, struct{}]{}))
+	})
+
+	s.Run("empty graphs", func() {
+		s.True(IsIsomorphic[int, struct{}](NewUndirectedGraph[int, struct{}](), NewUndirectedGraph[int, struct{}](), MatchOptions[int, struct{}]{}))
+	})
+}
+
+func (s *IsomorphismTestSuite) TestIsomorphisms() {
+	s.Run("every automorphism of a cycle", func() {
+		g := undirectedFromEdges(cycleEdges(5))
+		count := 0
+		for mapping := range Isomorphisms[int, struct{}](g, g, MatchOptions[int, struct{}]{}) {
+			s.Len(mapping, 5)
+			s.assertPreservesEdges(g, g, mapping)
+			count++
+		}
+		s.Equal(10, count)
+	})
+
+	s.Run("Petersen graph has 120 automorphisms", func() {
+		g := undirectedFromEdges(petersenEdges())
+		count := 0
+		for range Isomorphisms[int, struct{}](g, g, MatchOptions[int, struct{}]{}) {
+			count++
+		}
+		s.Equal(120, count)
+	})
+
+	s.Run("stops when the consumer breaks", func() {
+		g := undirectedFromEdges(cycleEdges(6))
+		count := 0
+		for range Isomorphisms[int, struct{}](g, g, MatchOptions[int, struct{}]{}) {
+			count++
+			break
+		}
+		s.Equal(1, count)
+	})
+}
+
+func (s *IsomorphismTestSuite) TestMatchOptions() {
+	type atom struct {
+		id      int
+		element string
+	}
+	molecule := func(offset int, bonds map[[2]int]int) *UndirectedGraph[atom, int] {
+		g := NewUndirectedGraph[atom, int]()
+		elements := []string{"C", "O", "H"}
+		for pair, order := range bonds {
+			g.AddEdge(atom{pair[0] + offset, elements[pair[0]]}, atom{pair[1] + offset, elements[pair[1]]}, order)
+		}
+		return g
+	}
+	g1 := molecule(0, map[[2]int]int{{0, 1}: 2, {0, 2}: 1})
+	g2 := molecule(10, map[[2]int]int{{0, 1}: 1, {0, 2}: 2})
+
+	sameElement := func(a, b atom) bool { return a.element == b.element }
+	sameOrder := func(a, b int) bool { return a == b }
+
+	s.True(IsIsomorphic[atom, int](g1, g2, MatchOptions[atom, int]{}))
+	s.True(IsIsomorphic[atom, int](g1, g2, MatchOptions[atom, int]{NodeMatch: sameElement}))
+	s.True(IsIsomorphic[atom, int](g1, g2, MatchOptions[atom, int]{EdgeMatch: sameOrder}))
+	s.False(IsIsomorphic[atom, int](g1, g2, MatchOptions[atom, int]{NodeMatch: sameElement, EdgeMatch: sameOrder}))
+}
+
+func (s *IsomorphismTestSuite) TestSubgraphIsomorphisms() {
+	s.Run("triangles in K4", func() {
+		k4 := undirectedFromEdges([][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}})
+		triangle := undirectedFromEdges([][2]int{{10, 11}, {11, 12}, {12, 10}})
+		count := 0
+		for mapping := range SubgraphIsomorphisms[int, struct{}](k4, triangle, MatchOptions[int, struct{}]{}) {
+			s.Len(mapping, 3)
+			s.assertPreservesEdges(triangle, k4, mapping)
+			count++
+		}
+		// Four triangles, each matched in 3! ways.
+		s.Equal(24, count)
+		s.True(IsSubgraphIsomorphic[int, struct{}](k4, triangle, MatchOptions[int, struct{}]{}))
+	})
+
+	s.Run("induced matching rejects extra edges", func() {
+		k4 := undirectedFromEdges([][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}})
+		path := undirectedFromEdges([][2]int{{10, 11}, {11, 12}})
+		s.False(IsSubgraphIsomorphic[int, struct{}](k4, path, MatchOptions[int, struct{}]{}))
+
+		count := 0
+		for mapping := range SubgraphMonomorphisms[int, struct{}](k4, path, MatchOptions[int, struct{}]{}) {
+			s.assertPreservesEdges(path, k4, mapping)
+			count++
+		}
+		s.Equal(24, count)
+	})
+
+	s.Run("directed pattern in a workflow", func() {
+		workflow := directedFromEdges([][2]int{{0, 1}, {1, 2}, {1, 3}, {2, 4}, {3, 4}, {4, 5}})
+		diamond := directedFromEdges([][2]int{{10, 11}, {10, 12}, {11, 13}, {12, 13}})
+		matches := []map[int]int{}
+		for mapping := range SubgraphIsomorphisms[int, struct{}](workflow, diamond, MatchOptions[int, struct{}]{}) {
+			matches = append(matches, mapping)
+		}
+		s.Len(matches, 2)
+		for _, m := range matches {
+			s.Equal(1, m[10])
+			s.Equal(4, m[13])
+		}
+
+		cycle := directedFromEdges([][2]int{{10, 11}, {11, 10}})
+		s.False(IsSubgraphIsomorphic[int, struct{}](workflow, cycle, MatchOptions[int, struct{}]{}))
+	})
+
+	s.Run("pattern larger than graph", func() {
+		small := undirectedFromEdges([][2]int{{0, 1}})
+		s.False(IsSubgraphIsomorphic[int, struct{}](small, undirectedFromEdges(cycleEdges(4)), MatchOptions[int, struct{}]{}))
+	})
+
+	s.Run("disconnected pattern", func() {
+		g := undirectedFromEdges(cycleEdges(6))
+		twoEdges := undirectedFromEdges([][2]int{{10, 11}, {12, 13}})
+		count := 0
+		for mapping := range SubgraphIsomorphisms[int, struct{}](g, twoEdges, MatchOptions[int, struct{}]{}) {
+			s.assertPreservesEdges(twoEdges, g, mapping)
+			count++
+		}
+		// Only opposite edges of C6 have no edge between them, and each of
+		// the 3 pairs is matched in 8 ways.
+		s.Equal(3*8, count)
+	})
+}
+
+func TestIsomorphismTestSuite(t *testing.T) {
+	suite.Run(t, new(IsomorphismTestSuite))
+}
@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type jsonEdge[N comparable, E any] struct {
+	From  N `json:"from"`
+	To    N `json:"to"`
+	Value E `json:"value"`
+}
+
+type jsonGraph[N comparable, E any] struct {
+	Directed bool             `json:"directed"`
+	Nodes    []N              `json:"nodes"`
+	Edges    []jsonEdge[N, E] `json:"edges"`
+}
+
+func marshalGraphJSON[N comparable, E any](g ReadOnlyGraph[N, E]) ([]byte, error) {
+	doc := jsonGraph[N, E]{
+		Directed: g.IsDirected(),
+		Nodes:    g.Vertices(),
+		Edges:    []jsonEdge[N, E]{},
+	}
+	for _, e := range edgeEntries(g) {
+		doc.Edges = append(doc.Edges, jsonEdge[N, E]{From: e.From, To: e.To, Value: e.Value})
+	}
+	return json.Marshal(doc)
+}
+
+func unmarshalGraphJSON[N comparable, E any](data []byte, g *genericAdjacencyListGraph[N, E], directed bool) error {
+	var doc jsonGraph[N, E]
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	if doc.Directed != directed {
+		return fmt.Errorf("graph: cannot decode directed=%t graph into directed=%t graph", doc.Directed, directed)
+	}
+	g.adj = make(map[N]map[N]E)
+	for _, node := range doc.Nodes {
+		g.addVertex(node)
+	}
+	for _, e := range doc.Edges {
+		if directed {
+			g.addVertex(e.From)
+			g.addVertex(e.To)
+			g.adj[e.From][e.To] = e.Value
+		} else {
+			g.addEdge(e.From, e.To, e.Value)
+		}
+	}
+	return nil
+}
+
+func (dg *DirectedGraph[N, E]) MarshalJSON() ([]byte, error) {
+	return marshalGraphJSON[N, E](dg)
+}
+
+func (dg *DirectedGraph[N, E]) UnmarshalJSON(data []byte) error {
+	if dg.g == nil {
+		dg.g = newGenericAdjacencyListGraph[N, E]()
+	}
+	return unmarshalGraphJSON(data, dg.g, true)
+}
+
+func (ug *UndirectedGraph[N, E]) MarshalJSON() ([]byte, error) {
+	return marshalGraphJSON[N, E](ug)
+}
+
+func (ug *UndirectedGraph[N, E]) UnmarshalJSON(data []byte) error {
+	if ug.g == nil {
+		ug.g = newGenericAdjacencyListGraph[N, E]()
+	}
+	if err := unmarshalGraphJSON(data, ug.g, false); err != nil {
+		return err
+	}
+	if ug.conn != nil {
+		ug.conn = newConnectivityIndex(ug.g)
+	}
+	return nil
+}
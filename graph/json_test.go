@@ -0,0 +1,92 @@
+package graph
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type jsonTestEdge struct {
+	Weight int    `json:"weight"`
+	Label  string `json:"label"`
+}
+
+type GraphJSONTestSuite struct {
+	suite.Suite
+}
+
+func (s *GraphJSONTestSuite) TestDirectedRoundTrip() {
+	g := NewDirectedGraph[string, jsonTestEdge]()
+	g.AddEdge("a", "b", jsonTestEdge{Weight: 1, Label: "ab"})
+	g.AddEdge("b", "a", jsonTestEdge{Weight: 2, Label: "ba"})
+	g.AddEdge("b", "c", jsonTestEdge{Weight: 3, Label: "bc"})
+	g.AddVertex("lonely")
+
+	data, err := json.Marshal(g)
+	s.NoError(err)
+
+	decoded := NewDirectedGraph[string, jsonTestEdge]()
+	s.NoError(json.Unmarshal(data, decoded))
+	s.ElementsMatch(g.Vertices(), decoded.Vertices())
+	s.Len(decoded.Edges(), 3)
+	edge, ok := decoded.Edge("b", "a")
+	s.True(ok)
+	s.Equal(jsonTestEdge{Weight: 2, Label: "ba"}, edge)
+	s.False(decoded.HasEdge("c", "b"))
+}
+
+func (s *GraphJSONTestSuite) TestUndirectedRoundTrip() {
+	g := NewUndirectedGraph[int, jsonTestEdge]()
+	g.AddEdge(1, 2, jsonTestEdge{Weight: 5})
+	g.AddEdge(2, 3, jsonTestEdge{Weight: 7})
+
+	data, err := json.Marshal(g)
+	s.NoError(err)
+
+	var decoded UndirectedGraph[int, jsonTestEdge]
+	s.NoError(json.Unmarshal(data, &decoded))
+	s.ElementsMatch([]int{1, 2, 3}, decoded.Vertices())
+	s.Len(decoded.Edges(), 2)
+	edge, ok := decoded.Edge(3, 2)
+	s.True(ok)
+	s.Equal(7, edge.Weight)
+}
+
+func (s *GraphJSONTestSuite) TestDocumentShape() {
+	g := NewDirectedGraph[int, int]()
+	g.AddEdge(1, 2, 9)
+
+	data, err := json.Marshal(g)
+	s.NoError(err)
+
+	var doc struct {
+		Directed bool  `json:"directed"`
+		Nodes    []int `json:"nodes"`
+		Edges    []struct {
+			From  int `json:"from"`
+			To    int `json:"to"`
+			Value int `json:"value"`
+		} `json:"edges"`
+	}
+	s.NoError(json.Unmarshal(data, &doc))
+	s.True(doc.Directed)
+	s.ElementsMatch([]int{1, 2}, doc.Nodes)
+	s.Len(doc.Edges, 1)
+	s.Equal(1, doc.Edges[0].From)
+	s.Equal(2, doc.Edges[0].To)
+	s.Equal(9, doc.Edges[0].Value)
+}
+
+func (s *GraphJSONTestSuite) TestDirectednessMismatch() {
+	g := NewUndirectedGraph[int, int]()
+	g.AddEdge(1, 2, 1)
+	data, err := json.Marshal(g)
+	s.NoError(err)
+
+	s.Error(json.Unmarshal(data, NewDirectedGraph[int, int]()))
+}
+
+func TestGraphJSONTestSuite(t *testing.T) {
+	suite.Run(t, new(GraphJSONTestSuite))
+}
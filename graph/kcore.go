@@ -0,0 +1,70 @@
+package graph
+
+// CoreNumbers returns the core number of each vertex: the largest k such that
+// the vertex belongs to a subgraph where every vertex has degree at least k.
+// It uses the Batagelj–Zaversnik bucket algorithm and ignores self-loops. It
+// fails with ErrDirected on a directed graph.
+func CoreNumbers[N comparable, E any](g ReadOnlyGraph[N, E]) (map[N]int, error) {
+	if err := requireUndirected(g, "core decomposition"); err != nil {
+		return nil, err
+	}
+	ig := newIndexedGraph[N, E](g)
+	n := ig.size()
+	degree := make([]int, n)
+	maxDegree := 0
+	for v := range degree {
+		degree[v] = ig.degree(v)
+		if degree[v] > maxDegree {
+			maxDegree = degree[v]
+		}
+	}
+	buckets := make([][]int, maxDegree+1)
+	for v, d := range degree {
+		buckets[d] = append(buckets[d], v)
+	}
+	done := make([]bool, n)
+	core := make(map[N]int, n)
+	for d := 0; d <= maxDegree; d++ {
+		for len(buckets[d]) > 0 {
+			v := buckets[d][len(buckets[d])-1]
+			buckets[d] = buckets[d][:len(buckets[d])-1]
+			if done[v] || degree[v] != d {
+				continue
+			}
+			done[v] = true
+			core[ig.nodes[v]] = d
+			for _, u := range ig.adj[v] {
+				if u == v || done[u] || degree[u] <= d {
+					continue
+				}
+				degree[u]--
+				buckets[degree[u]] = append(buckets[degree[u]], u)
+			}
+		}
+	}
+	return core, nil
+}
+
+// KCore returns a copy of the subgraph induced by the vertices whose core
+// number is at least k.
+func KCore[N comparable, E any](g ReadOnlyGraph[N, E], k int) (*UndirectedGraph[N, E], error) {
+	cores, err := CoreNumbers(g)
+	if err != nil {
+		return nil, err
+	}
+	sub := NewUndirectedGraph[N, E]()
+	for node, core := range cores {
+		if core >= k {
+			sub.AddVertex(node)
+		}
+	}
+	for _, node := range sub.Vertices() {
+		for _, u := range g.Neighbors(node) {
+			if sub.HasVertex(u) {
+				edge, _ := g.Edge(node, u)
+				sub.AddEdge(node, u, edge)
+			}
+		}
+	}
+	return sub, nil
+}
@@ -0,0 +1,68 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type KCoreTestSuite struct {
+	suite.Suite
+	g *UndirectedGraph[int, struct{}]
+}
+
+func (s *KCoreTestSuite) SetupTest() {
+	// K4 on 0-3, a triangle 4-5-6 hanging off 3, a pendant 7 and an isolated 8.
+	s.g = undirectedFromEdges([][2]int{
+		{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3},
+		{3, 4}, {4, 5}, {5, 6}, {6, 4},
+		{6, 7},
+	})
+	s.g.AddVertex(8)
+	s.g.AddEdge(0, 0, struct{}{})
+}
+
+func (s *KCoreTestSuite) TestCoreNumbers() {
+	core, err := CoreNumbers(s.g)
+	s.NoError(err)
+	s.Equal(map[int]int{0: 3, 1: 3, 2: 3, 3: 3, 4: 2, 5: 2, 6: 2, 7: 1, 8: 0}, core)
+}
+
+func (s *KCoreTestSuite) TestKCore() {
+	three, err := KCore(s.g, 3)
+	s.NoError(err)
+	s.ElementsMatch([]int{0, 1, 2, 3}, three.Vertices())
+	s.True(three.HasEdge(0, 3))
+
+	two, err := KCore(s.g, 2)
+	s.NoError(err)
+	s.ElementsMatch([]int{0, 1, 2, 3, 4, 5, 6}, two.Vertices())
+	s.True(two.HasEdge(3, 4))
+
+	four, err := KCore(s.g, 4)
+	s.NoError(err)
+	s.Empty(four.Vertices())
+
+	// The core is a copy.
+	three.RemoveVertex(0)
+	s.True(s.g.HasVertex(0))
+}
+
+func (s *KCoreTestSuite) TestReadOnlyBackends() {
+	frozen := s.g.Freeze()
+	core, err := CoreNumbers(frozen)
+	s.NoError(err)
+	want, _ := CoreNumbers(s.g)
+	s.Equal(want, core)
+
+	directed := NewDirectedGraph[int, struct{}]()
+	directed.AddEdge(0, 1, struct{}{})
+	_, err = CoreNumbers(directed)
+	s.ErrorIs(err, ErrDirected)
+	_, err = KCore(directed, 1)
+	s.ErrorIs(err, ErrDirected)
+}
+
+func TestKCoreTestSuite(t *testing.T) {
+	suite.Run(t, new(KCoreTestSuite))
+}
@@ -0,0 +1,77 @@
+package graph
+
+type MultiDirectedGraph[N comparable, E any] struct {
+	g *genericMultiGraph[N, E]
+}
+
+func NewMultiDirectedGraph[N comparable, E any]() *MultiDirectedGraph[N, E] {
+	return &MultiDirectedGraph[N, E]{g: newGenericMultiGraph[N, E](true)}
+}
+
+func (mg *MultiDirectedGraph[N, E]) AddVertex(node N) {
+	mg.g.addVertex(node)
+}
+
+func (mg *MultiDirectedGraph[N, E]) RemoveVertex(node N) {
+	mg.g.removeVertex(node)
+}
+
+func (mg *MultiDirectedGraph[N, E]) AddEdge(from, to N, edge E) EdgeID {
+	return mg.g.addEdge(from, to, edge)
+}
+
+func (mg *MultiDirectedGraph[N, E]) RemoveEdge(id EdgeID) bool {
+	return mg.g.removeEdge(id)
+}
+
+func (mg *MultiDirectedGraph[N, E]) RemoveEdgesBetween(from, to N) int {
+	return mg.g.removeEdgesBetween(from, to)
+}
+
+func (mg *MultiDirectedGraph[N, E]) UpdateEdge(id EdgeID, edge E) bool {
+	return mg.g.updateEdge(id, edge)
+}
+
+func (mg *MultiDirectedGraph[N, E]) Edge(id EdgeID) (MultiEdge[N, E], bool) {
+	return mg.g.edge(id)
+}
+
+func (mg *MultiDirectedGraph[N, E]) EdgesBetween(from, to N) []MultiEdge[N, E] {
+	return mg.g.edgesBetween(from, to)
+}
+
+func (mg *MultiDirectedGraph[N, E]) SelfLoops(node N) []MultiEdge[N, E] {
+	return mg.g.selfLoops(node)
+}
+
+func (mg *MultiDirectedGraph[N, E]) Neighbors(node N) []N {
+	return mg.g.neighbors(node)
+}
+
+func (mg *MultiDirectedGraph[N, E]) HasVertex(node N) bool {
+	return mg.g.hasVertex(node)
+}
+
+func (mg *MultiDirectedGraph[N, E]) HasEdge(from, to N) bool {
+	return mg.g.hasEdge(from, to)
+}
+
+func (mg *MultiDirectedGraph[N, E]) OutDegree(node N) int {
+	return mg.g.outDegree(node)
+}
+
+func (mg *MultiDirectedGraph[N, E]) InDegree(node N) int {
+	return mg.g.inDegree(node)
+}
+
+func (mg *MultiDirectedGraph[N, E]) Vertices() []N {
+	return mg.g.vertices()
+}
+
+func (mg *MultiDirectedGraph[N, E]) Edges() []MultiEdge[N, E] {
+	return mg.g.allEdges()
+}
+
+func (mg *MultiDirectedGraph[N, E]) EdgeCount() int {
+	return mg.g.edgeCount()
+}
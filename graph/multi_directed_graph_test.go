@@ -0,0 +1,74 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MultiDirectedGraphTestSuite struct {
+	suite.Suite
+	g *MultiDirectedGraph[string, testEdgeDirected]
+}
+
+func (s *MultiDirectedGraphTestSuite) SetupTest() {
+	s.g = NewMultiDirectedGraph[string, testEdgeDirected]()
+}
+
+func (s *MultiDirectedGraphTestSuite) TestMultiDirectedGraph() {
+	s.Run("ParallelEdges", func() {
+		rail := s.g.AddEdge("paris", "lyon", testEdgeDirected{label: "rail"})
+		road := s.g.AddEdge("paris", "lyon", testEdgeDirected{label: "road"})
+		es := s.g.EdgesBetween("paris", "lyon")
+		s.Len(es, 2)
+		s.Equal(rail, es[0].ID)
+		s.Equal(road, es[1].ID)
+		s.Equal("road", es[1].Value.label)
+		s.Empty(s.g.EdgesBetween("lyon", "paris"))
+		s.True(s.g.HasEdge("paris", "lyon"))
+		s.False(s.g.HasEdge("lyon", "paris"))
+	})
+	s.Run("Degrees", func() {
+		s.g.AddEdge("a", "b", testEdgeDirected{label: "1"})
+		s.g.AddEdge("a", "b", testEdgeDirected{label: "2"})
+		s.g.AddEdge("a", "a", testEdgeDirected{label: "loop"})
+		s.Equal(3, s.g.OutDegree("a"))
+		s.Equal(1, s.g.InDegree("a"))
+		s.Equal(2, s.g.InDegree("b"))
+		s.Len(s.g.SelfLoops("a"), 1)
+	})
+	s.Run("UpdateAndLookupEdge", func() {
+		id := s.g.AddEdge("x", "y", testEdgeDirected{label: "old"})
+		s.True(s.g.UpdateEdge(id, testEdgeDirected{label: "new"}))
+		e, ok := s.g.Edge(id)
+		s.True(ok)
+		s.Equal("x", e.From)
+		s.Equal("y", e.To)
+		s.Equal("new", e.Value.label)
+		s.False(s.g.UpdateEdge(EdgeID(-1), testEdgeDirected{}))
+	})
+	s.Run("RemoveEdgesBetween", func() {
+		s.g.AddEdge("m", "n", testEdgeDirected{label: "1"})
+		s.g.AddEdge("m", "n", testEdgeDirected{label: "2"})
+		s.g.AddEdge("n", "m", testEdgeDirected{label: "back"})
+		s.Equal(2, s.g.RemoveEdgesBetween("m", "n"))
+		s.False(s.g.HasEdge("m", "n"))
+		s.True(s.g.HasEdge("n", "m"))
+	})
+	s.Run("VerticesAndEdges", func() {
+		before := s.g.EdgeCount()
+		s.g.AddVertex("p")
+		s.g.AddEdge("q", "r", testEdgeDirected{label: "1"})
+		s.g.AddEdge("q", "r", testEdgeDirected{label: "2"})
+		s.Subset(s.g.Vertices(), []string{"p", "q", "r"})
+		s.Len(s.g.Edges(), before+2)
+		s.Equal(before+2, s.g.EdgeCount())
+		s.g.RemoveVertex("r")
+		s.Equal(before, s.g.EdgeCount())
+		s.Empty(s.g.Neighbors("q"))
+	})
+}
+
+func TestMultiDirectedGraphTestSuite(t *testing.T) {
+	suite.Run(t, new(MultiDirectedGraphTestSuite))
+}
@@ -0,0 +1,74 @@
+package graph
+
+type MultiUndirectedGraph[N comparable, E any] struct {
+	g *genericMultiGraph[N, E]
+}
+
+func NewMultiUndirectedGraph[N comparable, E any]() *MultiUndirectedGraph[N, E] {
+	return &MultiUndirectedGraph[N, E]{g: newGenericMultiGraph[N, E](false)}
+}
+
+func (mug *MultiUndirectedGraph[N, E]) AddVertex(node N) {
+	mug.g.addVertex(node)
+}
+
+func (mug *MultiUndirectedGraph[N, E]) RemoveVertex(node N) {
+	mug.g.removeVertex(node)
+}
+
+func (mug *MultiUndirectedGraph[N, E]) AddEdge(from, to N, edge E) EdgeID {
+	return mug.g.addEdge(from, to, edge)
+}
+
+func (mug *MultiUndirectedGraph[N, E]) RemoveEdge(id EdgeID) bool {
+	return mug.g.removeEdge(id)
+}
+
+func (mug *MultiUndirectedGraph[N, E]) RemoveEdgesBetween(from, to N) int {
+	return mug.g.removeEdgesBetween(from, to)
+}
+
+func (mug *MultiUndirectedGraph[N, E]) UpdateEdge(id EdgeID, edge E) bool {
+	return mug.g.updateEdge(id, edge)
+}
+
+func (mug *MultiUndirectedGraph[N, E]) Edge(id EdgeID) (MultiEdge[N, E], bool) {
+	return mug.g.edge(id)
+}
+
+func (mug *MultiUndirectedGraph[N, E]) EdgesBetween(from, to N) []MultiEdge[N, E] {
+	return mug.g.edgesBetween(from, to)
+}
+
+func (mug *MultiUndirectedGraph[N, E]) SelfLoops(node N) []MultiEdge[N, E] {
+	return mug.g.selfLoops(node)
+}
+
+func (mug *MultiUndirectedGraph[N, E]) Neighbors(node N) []N {
+	return mug.g.neighbors(node)
+}
+
+func (mug *MultiUndirectedGraph[N, E]) HasVertex(node N) bool {
+	return mug.g.hasVertex(node)
+}
+
+func (mug *MultiUndirectedGraph[N, E]) HasEdge(from, to N) bool {
+	return mug.g.hasEdge(from, to)
+}
+
+// Degree counts each self-loop twice, once for each of its endpoints.
+func (mug *MultiUndirectedGraph[N, E]) Degree(node N) int {
+	return mug.g.outDegree(node)
+}
+
+func (mug *MultiUndirectedGraph[N, E]) Vertices() []N {
+	return mug.g.vertices()
+}
+
+func (mug *MultiUndirectedGraph[N, E]) Edges() []MultiEdge[N, E] {
+	return mug.g.allEdges()
+}
+
+func (mug *MultiUndirectedGraph[N, E]) EdgeCount() int {
+	return mug.g.edgeCount()
+}
@@ -0,0 +1,65 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MultiUndirectedGraphTestSuite struct {
+	suite.Suite
+	g *MultiUndirectedGraph[string, testEdgeUndirected]
+}
+
+func (s *MultiUndirectedGraphTestSuite) SetupTest() {
+	s.g = NewMultiUndirectedGraph[string, testEdgeUndirected]()
+}
+
+func (s *MultiUndirectedGraphTestSuite) TestMultiUndirectedGraph() {
+	s.Run("ParallelEdges", func() {
+		rail := s.g.AddEdge("paris", "lyon", testEdgeUndirected{label: "rail"})
+		road := s.g.AddEdge("lyon", "paris", testEdgeUndirected{label: "road"})
+		es := s.g.EdgesBetween("paris", "lyon")
+		s.Len(es, 2)
+		s.Equal(rail, es[0].ID)
+		s.Equal(road, es[1].ID)
+		s.Len(s.g.EdgesBetween("lyon", "paris"), 2)
+		s.Equal(2, s.g.Degree("paris"))
+		s.Equal([]string{"lyon"}, s.g.Neighbors("paris"))
+	})
+	s.Run("SelfLoops", func() {
+		id := s.g.AddEdge("a", "a", testEdgeUndirected{label: "loop"})
+		s.g.AddEdge("a", "b", testEdgeUndirected{label: "a-b"})
+		s.True(s.g.HasEdge("a", "a"))
+		s.Len(s.g.SelfLoops("a"), 1)
+		s.Len(s.g.EdgesBetween("a", "a"), 1)
+		s.Equal(3, s.g.Degree("a"))
+		s.ElementsMatch([]string{"a", "b"}, s.g.Neighbors("a"))
+		s.True(s.g.RemoveEdge(id))
+		s.False(s.g.HasEdge("a", "a"))
+		s.Equal(1, s.g.Degree("a"))
+	})
+	s.Run("RemoveEdgeByID", func() {
+		id1 := s.g.AddEdge("x", "y", testEdgeUndirected{label: "1"})
+		id2 := s.g.AddEdge("x", "y", testEdgeUndirected{label: "2"})
+		s.True(s.g.RemoveEdge(id1))
+		es := s.g.EdgesBetween("y", "x")
+		s.Len(es, 1)
+		s.Equal(id2, es[0].ID)
+		s.Equal(1, s.g.RemoveEdgesBetween("y", "x"))
+		s.False(s.g.HasEdge("x", "y"))
+	})
+	s.Run("RemoveVertex", func() {
+		before := s.g.EdgeCount()
+		s.g.AddEdge("m", "n", testEdgeUndirected{label: "1"})
+		s.g.AddEdge("m", "m", testEdgeUndirected{label: "loop"})
+		s.g.RemoveVertex("m")
+		s.False(s.g.HasVertex("m"))
+		s.Empty(s.g.Neighbors("n"))
+		s.Equal(before, s.g.EdgeCount())
+	})
+}
+
+func TestMultiUndirectedGraphTestSuite(t *testing.T) {
+	suite.Run(t, new(MultiUndirectedGraphTestSuite))
+}
@@ -0,0 +1,196 @@
+package graph
+
+type EdgeID int
+
+type MultiEdge[N comparable, E any] struct {
+	ID    EdgeID
+	From  N
+	To    N
+	Value E
+}
+
+// genericMultiGraph keeps every edge under its own ID so parallel edges between
+// the same pair of nodes never overwrite each other. Self-loops are stored once
+// in adj[n][n], even for undirected graphs. incident holds the IDs of the
+// edges touching each node in either direction, so removing a node or
+// counting its in-edges costs O(degree) rather than a scan of the graph.
+type genericMultiGraph[N comparable, E any] struct {
+	directed bool
+	nextID   EdgeID
+	adj      map[N]map[N][]EdgeID
+	incident map[N]map[EdgeID]struct{}
+	edges    map[EdgeID]MultiEdge[N, E]
+}
+
+func newGenericMultiGraph[N comparable, E any](directed bool) *genericMultiGraph[N, E] {
+	return &genericMultiGraph[N, E]{
+		directed: directed,
+		adj:      make(map[N]map[N][]EdgeID),
+		incident: make(map[N]map[EdgeID]struct{}),
+		edges:    make(map[EdgeID]MultiEdge[N, E]),
+	}
+}
+
+func (g *genericMultiGraph[N, E]) addVertex(node N) {
+	if _, exists := g.adj[node]; !exists {
+		g.adj[node] = make(map[N][]EdgeID)
+		g.incident[node] = make(map[EdgeID]struct{})
+	}
+}
+
+func (g *genericMultiGraph[N, E]) removeVertex(node N) {
+	if _, exists := g.adj[node]; !exists {
+		return
+	}
+	for id := range g.incident[node] {
+		g.removeEdge(id)
+	}
+	delete(g.adj, node)
+	delete(g.incident, node)
+}
+
+func (g *genericMultiGraph[N, E]) addEdge(from, to N, edge E) EdgeID {
+	g.addVertex(from)
+	g.addVertex(to)
+	g.nextID++
+	id := g.nextID
+	g.edges[id] = MultiEdge[N, E]{ID: id, From: from, To: to, Value: edge}
+	g.adj[from][to] = append(g.adj[from][to], id)
+	g.incident[from][id] = struct{}{}
+	g.incident[to][id] = struct{}{}
+	if !g.directed && from != to {
+		g.adj[to][from] = append(g.adj[to][from], id)
+	}
+	return id
+}
+
+func (g *genericMultiGraph[N, E]) removeEdge(id EdgeID) bool {
+	e, ok := g.edges[id]
+	if !ok {
+		return false
+	}
+	delete(g.edges, id)
+	delete(g.incident[e.From], id)
+	delete(g.incident[e.To], id)
+	g.unlink(e.From, e.To, id)
+	if !g.directed && e.From != e.To {
+		g.unlink(e.To, e.From, id)
+	}
+	return true
+}
+
+func (g *genericMultiGraph[N, E]) unlink(from, to N, id EdgeID) {
+	ids := g.adj[from][to]
+	for i, existing := range ids {
+		if existing == id {
+			ids = append(ids[:i:i], ids[i+1:]...)
+			break
+		}
+	}
+	if len(ids) == 0 {
+		delete(g.adj[from], to)
+	} else {
+		g.adj[from][to] = ids
+	}
+}
+
+func (g *genericMultiGraph[N, E]) removeEdgesBetween(from, to N) int {
+	ids := append([]EdgeID{}, g.adj[from][to]...)
+	for _, id := range ids {
+		g.removeEdge(id)
+	}
+	return len(ids)
+}
+
+func (g *genericMultiGraph[N, E]) updateEdge(id EdgeID, edge E) bool {
+	e, ok := g.edges[id]
+	if !ok {
+		return false
+	}
+	e.Value = edge
+	g.edges[id] = e
+	return true
+}
+
+func (g *genericMultiGraph[N, E]) edge(id EdgeID) (MultiEdge[N, E], bool) {
+	e, ok := g.edges[id]
+	return e, ok
+}
+
+func (g *genericMultiGraph[N, E]) edgesBetween(from, to N) []MultiEdge[N, E] {
+	ids := g.adj[from][to]
+	es := make([]MultiEdge[N, E], 0, len(ids))
+	for _, id := range ids {
+		es = append(es, g.edges[id])
+	}
+	return es
+}
+
+func (g *genericMultiGraph[N, E]) neighbors(node N) []N {
+	neighbors := []N{}
+	if adj, ok := g.adj[node]; ok {
+		for n := range adj {
+			neighbors = append(neighbors, n)
+		}
+	}
+	return neighbors
+}
+
+func (g *genericMultiGraph[N, E]) hasVertex(node N) bool {
+	_, ok := g.adj[node]
+	return ok
+}
+
+func (g *genericMultiGraph[N, E]) hasEdge(from, to N) bool {
+	return len(g.adj[from][to]) > 0
+}
+
+func (g *genericMultiGraph[N, E]) vertices() []N {
+	vs := make([]N, 0, len(g.adj))
+	for v := range g.adj {
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+func (g *genericMultiGraph[N, E]) allEdges() []MultiEdge[N, E] {
+	es := make([]MultiEdge[N, E], 0, len(g.edges))
+	for _, e := range g.edges {
+		es = append(es, e)
+	}
+	return es
+}
+
+func (g *genericMultiGraph[N, E]) edgeCount() int {
+	return len(g.edges)
+}
+
+// outDegree counts the edges leaving node. For undirected graphs this is the
+// degree, with each self-loop counted twice.
+func (g *genericMultiGraph[N, E]) outDegree(node N) int {
+	degree := 0
+	for to, ids := range g.adj[node] {
+		degree += len(ids)
+		if !g.directed && to == node {
+			degree += len(ids)
+		}
+	}
+	return degree
+}
+
+func (g *genericMultiGraph[N, E]) inDegree(node N) int {
+	if !g.directed {
+		return g.outDegree(node)
+	}
+	degree := 0
+	for id := range g.incident[node] {
+		if g.edges[id].To == node {
+			degree++
+		}
+	}
+	return degree
+}
+
+func (g *genericMultiGraph[N, E]) selfLoops(node N) []MultiEdge[N, E] {
+	return g.edgesBetween(node, node)
+}
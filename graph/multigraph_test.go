@@ -0,0 +1,75 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CoreMultiGraphTestSuite struct {
+	suite.Suite
+	g *genericMultiGraph[int, testEdgeGraph]
+}
+
+func (s *CoreMultiGraphTestSuite) SetupTest() {
+	s.g = newGenericMultiGraph[int, testEdgeGraph](false)
+}
+
+func (s *CoreMultiGraphTestSuite) TestCoreMultiGraph() {
+	s.Run("ParallelEdgesGetDistinctIDs", func() {
+		id1 := s.g.addEdge(1, 2, testEdgeGraph{weight: 10})
+		id2 := s.g.addEdge(1, 2, testEdgeGraph{weight: 20})
+		s.NotEqual(id1, id2)
+		s.Len(s.g.edgesBetween(1, 2), 2)
+		s.Len(s.g.edgesBetween(2, 1), 2)
+		s.Equal(2, s.g.edgeCount())
+	})
+	s.Run("RemoveEdgeKeepsParallelEdges", func() {
+		id1 := s.g.addEdge(3, 4, testEdgeGraph{weight: 1})
+		id2 := s.g.addEdge(3, 4, testEdgeGraph{weight: 2})
+		s.True(s.g.removeEdge(id1))
+		s.False(s.g.removeEdge(id1))
+		es := s.g.edgesBetween(4, 3)
+		s.Len(es, 1)
+		s.Equal(id2, es[0].ID)
+		s.True(s.g.removeEdge(id2))
+		s.False(s.g.hasEdge(3, 4))
+		s.Empty(s.g.neighbors(3))
+	})
+	s.Run("SelfLoopStoredOnce", func() {
+		id := s.g.addEdge(5, 5, testEdgeGraph{weight: 3})
+		s.Len(s.g.selfLoops(5), 1)
+		s.Equal(2, s.g.outDegree(5))
+		s.True(s.g.removeEdge(id))
+		s.Empty(s.g.selfLoops(5))
+		s.Equal(0, s.g.outDegree(5))
+	})
+	s.Run("RemoveVertexDropsIncidentEdges", func() {
+		s.g.addEdge(6, 7, testEdgeGraph{weight: 1})
+		s.g.addEdge(7, 8, testEdgeGraph{weight: 1})
+		before := s.g.edgeCount()
+		s.g.removeVertex(7)
+		s.False(s.g.hasVertex(7))
+		s.False(s.g.hasEdge(6, 7))
+		s.Equal(before-2, s.g.edgeCount())
+	})
+	s.Run("RemoveVertexKeepsIncidentIndex", func() {
+		g := newGenericMultiGraph[int, testEdgeGraph](true)
+		g.addEdge(1, 2, testEdgeGraph{weight: 1})
+		g.addEdge(2, 1, testEdgeGraph{weight: 2})
+		g.addEdge(2, 2, testEdgeGraph{weight: 3})
+		kept := g.addEdge(1, 3, testEdgeGraph{weight: 4})
+		s.Equal(2, g.inDegree(2))
+		g.removeVertex(2)
+		s.Equal(1, g.edgeCount())
+		s.Empty(g.edgesBetween(1, 2))
+		s.Equal(map[EdgeID]struct{}{kept: {}}, g.incident[1])
+		s.Equal(map[EdgeID]struct{}{kept: {}}, g.incident[3])
+		s.Equal(1, g.inDegree(3))
+		s.Equal(0, g.inDegree(1))
+	})
+}
+
+func TestCoreMultiGraphTestSuite(t *testing.T) {
+	suite.Run(t, new(CoreMultiGraphTestSuite))
+}
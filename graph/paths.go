@@ -0,0 +1,329 @@
+package graph
+
+import (
+	"container/heap"
+	"fmt"
+	"iter"
+	"math"
+	"slices"
+)
+
+// Path is a route between two vertices together with its total weight.
+type Path[N comparable] struct {
+	Nodes []N
+	Cost  float64
+}
+
+// pathWeights holds the weight of every edge of an indexed graph. Self-loops
+// are dropped since they never shorten a path.
+type pathWeights []map[int]float64
+
+func newPathWeights[N comparable, E any](g ReadOnlyGraph[N, E], ig *indexedGraph[N], weight func(E) float64) (pathWeights, error) {
+	w := make(pathWeights, ig.size())
+	for i := range w {
+		w[i] = make(map[int]float64, len(ig.adj[i]))
+		for _, j := range ig.adj[i] {
+			if i == j {
+				continue
+			}
+			cost := 1.0
+			if weight != nil {
+				edge, _ := g.Edge(ig.nodes[i], ig.nodes[j])
+				cost = weight(edge)
+			}
+			if cost < 0 || math.IsNaN(cost) {
+				return nil, fmt.Errorf("graph: edge %v -> %v has invalid weight %v", ig.nodes[i], ig.nodes[j], cost)
+			}
+			w[i][j] = cost
+		}
+	}
+	return w, nil
+}
+
+func sameCost(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*math.Max(1, math.Abs(b))
+}
+
+type dijkstraItem struct {
+	node int
+	dist float64
+}
+
+type dijkstraQueue []dijkstraItem
+
+func (q dijkstraQueue) Len() int { return len(q) }
+func (q dijkstraQueue) Less(i, j int) bool {
+	if q[i].dist != q[j].dist {
+		return q[i].dist < q[j].dist
+	}
+	return q[i].node < q[j].node
+}
+func (q dijkstraQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *dijkstraQueue) Push(x any)   { *q = append(*q, x.(dijkstraItem)) }
+func (q *dijkstraQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+// dijkstra returns the distance from source to every vertex, skipping the
+// blocked vertices and edges. Unreachable vertices are at +Inf.
+func (w pathWeights) dijkstra(source int, blockedNodes map[int]bool, blockedEdges map[[2]int]bool) ([]float64, []int) {
+	dist := make([]float64, len(w))
+	prev := make([]int, len(w))
+	for i := range dist {
+		dist[i] = math.Inf(1)
+		prev[i] = -1
+	}
+	dist[source] = 0
+	q := &dijkstraQueue{{node: source}}
+	for q.Len() > 0 {
+		item := heap.Pop(q).(dijkstraItem)
+		if item.dist > dist[item.node] {
+			continue
+		}
+		neighbors := make([]int, 0, len(w[item.node]))
+		for v := range w[item.node] {
+			neighbors = append(neighbors, v)
+		}
+		slices.Sort(neighbors)
+		for _, v := range neighbors {
+			if blockedNodes[v] || blockedEdges[[2]int{item.node, v}] {
+				continue
+			}
+			if d := item.dist + w[item.node][v]; d < dist[v] {
+				dist[v], prev[v] = d, item.node
+				heap.Push(q, dijkstraItem{node: v, dist: d})
+			}
+		}
+	}
+	return dist, prev
+}
+
+func (w pathWeights) shortestPath(source, target int, blockedNodes map[int]bool, blockedEdges map[[2]int]bool) ([]int, float64, bool) {
+	dist, prev := w.dijkstra(source, blockedNodes, blockedEdges)
+	if math.IsInf(dist[target], 1) {
+		return nil, 0, false
+	}
+	route := []int{}
+	for v := target; v != -1; v = prev[v] {
+		route = append(route, v)
+	}
+	slices.Reverse(route)
+	return route, dist[target], true
+}
+
+func (w pathWeights) cost(route []int) float64 {
+	cost := 0.0
+	for i := 1; i < len(route); i++ {
+		cost += w[route[i-1]][route[i]]
+	}
+	return cost
+}
+
+func pathEndpoints[N comparable](ig *indexedGraph[N], from, to N) (int, int, error) {
+	s, ok := ig.index[from]
+	if !ok {
+		return 0, 0, fmt.Errorf("graph: vertex %v not found", from)
+	}
+	t, ok := ig.index[to]
+	if !ok {
+		return 0, 0, fmt.Errorf("graph: vertex %v not found", to)
+	}
+	return s, t, nil
+}
+
+func makePath[N comparable](ig *indexedGraph[N], route []int, cost float64) Path[N] {
+	nodes := make([]N, len(route))
+	for i, v := range route {
+		nodes[i] = ig.nodes[v]
+	}
+	return Path[N]{Nodes: nodes, Cost: cost}
+}
+
+// KShortestPaths returns up to k loopless paths from one vertex to another in
+// order of increasing cost, using Yen's algorithm. A nil weight counts every
+// edge as 1; weights must not be negative. It returns ErrNoPath when to cannot
+// be reached at all, nil for k == 0 and an error for negative k.
+func KShortestPaths[N comparable, E any](g ReadOnlyGraph[N, E], from, to N, k int, weight func(E) float64) ([]Path[N], error) {
+	if k < 0 {
+		return nil, fmt.Errorf("graph: k must not be negative, got %d", k)
+	}
+	if k == 0 {
+		return nil, nil
+	}
+	ig := newSortedIndexedGraph[N, E](g)
+	s, t, err := pathEndpoints(ig, from, to)
+	if err != nil {
+		return nil, err
+	}
+	w, err := newPathWeights(g, ig, weight)
+	if err != nil {
+		return nil, err
+	}
+	first, cost, ok := w.shortestPath(s, t, nil, nil)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v is not reachable from %v", ErrNoPath, to, from)
+	}
+
+	type candidate struct {
+		route []int
+		cost  float64
+	}
+	accepted := []candidate{{first, cost}}
+	seen := map[string]bool{fmt.Sprint(first): true}
+	pending := []candidate{}
+	for len(accepted) < k {
+		last := accepted[len(accepted)-1].route
+		for i := 0; i < len(last)-1; i++ {
+			root := last[:i+1]
+			blockedEdges := make(map[[2]int]bool)
+			for _, a := range accepted {
+				if len(a.route) > i+1 && slices.Equal(a.route[:i+1], root) {
+					blockedEdges[[2]int{a.route[i], a.route[i+1]}] = true
+				}
+			}
+			blockedNodes := make(map[int]bool, i)
+			for _, v := range root[:i] {
+				blockedNodes[v] = true
+			}
+			spur, _, ok := w.shortestPath(last[i], t, blockedNodes, blockedEdges)
+			if !ok {
+				continue
+			}
+			route := append(slices.Clone(root[:i]), spur...)
+			if key := fmt.Sprint(route); !seen[key] {
+				seen[key] = true
+				pending = append(pending, candidate{route, w.cost(route)})
+			}
+		}
+		if len(pending) == 0 {
+			break
+		}
+		best := 0
+		for i, c := range pending {
+			b := pending[best]
+			if c.cost < b.cost && !sameCost(c.cost, b.cost) ||
+				sameCost(c.cost, b.cost) && (len(c.route) < len(b.route) || len(c.route) == len(b.route) && slices.Compare(c.route, b.route) < 0) {
+				best = i
+			}
+		}
+		accepted = append(accepted, pending[best])
+		pending = slices.Delete(pending, best, best+1)
+	}
+
+	paths := make([]Path[N], len(accepted))
+	for i, c := range accepted {
+		paths[i] = makePath(ig, c.route, c.cost)
+	}
+	return paths, nil
+}
+
+// AllShortestPaths returns every simple path from one vertex to another whose
+// cost equals the minimum; paths that would go round a zero-weight cycle are
+// left out. A nil weight counts every edge as 1; weights must not be
+// negative. It returns ErrNoPath when to cannot be reached.
+func AllShortestPaths[N comparable, E any](g ReadOnlyGraph[N, E], from, to N, weight func(E) float64) ([]Path[N], error) {
+	ig := newSortedIndexedGraph[N, E](g)
+	s, t, err := pathEndpoints(ig, from, to)
+	if err != nil {
+		return nil, err
+	}
+	w, err := newPathWeights(g, ig, weight)
+	if err != nil {
+		return nil, err
+	}
+	dist, _ := w.dijkstra(s, nil, nil)
+	if math.IsInf(dist[t], 1) {
+		return nil, fmt.Errorf("%w: %v is not reachable from %v", ErrNoPath, to, from)
+	}
+
+	// tight reports whether the edge u -> v lies on some shortest path from s.
+	tight := func(u, v int) bool {
+		return !math.IsInf(dist[u], 1) && sameCost(dist[u]+w[u][v], dist[v])
+	}
+	predecessors := make([][]int, ig.size())
+	for u := range w {
+		for v := range w[u] {
+			predecessors[v] = append(predecessors[v], u)
+		}
+	}
+	leadsToTarget := make([]bool, ig.size())
+	leadsToTarget[t] = true
+	stack := []int{t}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, u := range predecessors[v] {
+			if !leadsToTarget[u] && tight(u, v) {
+				leadsToTarget[u] = true
+				stack = append(stack, u)
+			}
+		}
+	}
+
+	paths := []Path[N]{}
+	route := []int{s}
+	onPath := make([]bool, ig.size())
+	onPath[s] = true
+	var walk func(u int)
+	walk = func(u int) {
+		if u == t {
+			paths = append(paths, makePath(ig, route, dist[t]))
+			return
+		}
+		for _, v := range ig.adj[u] {
+			if _, ok := w[u][v]; ok && !onPath[v] && leadsToTarget[v] && tight(u, v) {
+				onPath[v] = true
+				route = append(route, v)
+				walk(v)
+				route = route[:len(route)-1]
+				onPath[v] = false
+			}
+		}
+	}
+	walk(s)
+	return paths, nil
+}
+
+// AllSimplePaths yields every path from one vertex to another that repeats no
+// vertex, each as a fresh slice, in depth-first order. maxDepth limits the
+// number of edges in a path; zero or less means no limit. Nothing is yielded
+// when either vertex is missing, and from == to yields the single-vertex path.
+func AllSimplePaths[N comparable, E any](g ReadOnlyGraph[N, E], from, to N, maxDepth int) iter.Seq[[]N] {
+	return func(yield func([]N) bool) {
+		ig := newSortedIndexedGraph[N, E](g)
+		s, t, err := pathEndpoints(ig, from, to)
+		if err != nil {
+			return
+		}
+		onPath := make([]bool, ig.size())
+		route := []int{s}
+		onPath[s] = true
+		var walk func(u int) bool
+		walk = func(u int) bool {
+			if u == t {
+				return yield(makePath(ig, route, 0).Nodes)
+			}
+			if maxDepth > 0 && len(route)-1 >= maxDepth {
+				return true
+			}
+			for _, v := range ig.adj[u] {
+				if onPath[v] {
+					continue
+				}
+				onPath[v] = true
+				route = append(route, v)
+				more := walk(v)
+				route = route[:len(route)-1]
+				onPath[v] = false
+				if !more {
+					return false
+				}
+			}
+			return true
+		}
+		walk(s)
+	}
+}
@@ -0,0 +1,203 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PathsTestSuite struct {
+	suite.Suite
+	roads *DirectedGraph[string, float64]
+}
+
+func (s *PathsTestSuite) SetupTest() {
+	// The classic example from Yen's algorithm write-ups.
+	s.roads = NewDirectedGraph[string, float64]()
+	for _, e := range []struct {
+		from, to string
+		cost     float64
+	}{
+		{"C", "D", 3}, {"C", "E", 2}, {"D", "F", 4}, {"E", "D", 1},
+		{"E", "F", 2}, {"E", "G", 3}, {"F", "G", 2}, {"F", "H", 1},
+		{"G", "H", 2},
+	} {
+		s.roads.AddEdge(e.from, e.to, e.cost)
+	}
+}
+
+func (s *PathsTestSuite) TestKShortestPaths() {
+	s.Run("weighted directed graph", func() {
+		paths, err := KShortestPaths[string, float64](s.roads, "C", "H", 3, identityWeight)
+		s.NoError(err)
+		s.Require().Len(paths, 3)
+		s.Equal([]string{"C", "E", "F", "H"}, paths[0].Nodes)
+		s.Equal(5.0, paths[0].Cost)
+		s.Equal([]string{"C", "E", "G", "H"}, paths[1].Nodes)
+		s.Equal(7.0, paths[1].Cost)
+		s.Equal([]string{"C", "D", "F", "H"}, paths[2].Nodes)
+		s.Equal(8.0, paths[2].Cost)
+	})
+
+	s.Run("fewer paths than requested", func() {
+		paths, err := KShortestPaths[string, float64](s.roads, "C", "H", 100, identityWeight)
+		s.NoError(err)
+		s.Len(paths, 7)
+		seen := map[string]bool{}
+		for i, p := range paths {
+			if i > 0 {
+				s.GreaterOrEqual(p.Cost, paths[i-1].Cost)
+			}
+			key := ""
+			visited := map[string]bool{}
+			for _, node := range p.Nodes {
+				s.False(visited[node], "path %v repeats %s", p.Nodes, node)
+				visited[node] = true
+				key += node
+			}
+			s.False(seen[key])
+			seen[key] = true
+		}
+	})
+
+	s.Run("undirected graph with unit weights", func() {
+		g := undirectedFromEdges(cycleEdges(6))
+		paths, err := KShortestPaths[int, struct{}](g, 0, 3, 3, nil)
+		s.NoError(err)
+		s.Len(paths, 2)
+		s.ElementsMatch([][]int{{0, 1, 2, 3}, {0, 5, 4, 3}}, [][]int{paths[0].Nodes, paths[1].Nodes})
+		s.Equal(3.0, paths[1].Cost)
+	})
+
+	s.Run("errors", func() {
+		_, err := KShortestPaths[string, float64](s.roads, "H", "C", 2, identityWeight)
+		s.True(errors.Is(err, ErrNoPath))
+
+		_, err = KShortestPaths[string, float64](s.roads, "C", "Z", 2, identityWeight)
+		s.Error(err)
+
+		s.roads.AddEdge("C", "H", -1)
+		_, err = KShortestPaths[string, float64](s.roads, "C", "H", 2, identityWeight)
+		s.Error(err)
+	})
+
+	s.Run("k of zero or less", func() {
+		paths, err := KShortestPaths[string, float64](s.roads, "C", "H", 0, identityWeight)
+		s.NoError(err)
+		s.Nil(paths)
+
+		paths, err = KShortestPaths[string, float64](s.roads, "C", "H", -1, identityWeight)
+		s.Error(err)
+		s.Nil(paths)
+	})
+}
+
+func (s *PathsTestSuite) TestAllShortestPaths() {
+	s.Run("ties in a grid", func() {
+		// 2x3 grid: 0-1-2 over 3-4-5.
+		g := undirectedFromEdges([][2]int{{0, 1}, {1, 2}, {3, 4}, {4, 5}, {0, 3}, {1, 4}, {2, 5}})
+		paths, err := AllShortestPaths[int, struct{}](g, 0, 5, nil)
+		s.NoError(err)
+		nodes := [][]int{}
+		for _, p := range paths {
+			s.Equal(3.0, p.Cost)
+			nodes = append(nodes, p.Nodes)
+		}
+		s.ElementsMatch([][]int{{0, 1, 2, 5}, {0, 1, 4, 5}, {0, 3, 4, 5}}, nodes)
+	})
+
+	s.Run("weights break ties", func() {
+		paths, err := AllShortestPaths[string, float64](s.roads, "C", "H", identityWeight)
+		s.NoError(err)
+		s.Len(paths, 1)
+		s.Equal([]string{"C", "E", "F", "H"}, paths[0].Nodes)
+
+		s.roads.AddEdge("E", "G", 1)
+		paths, err = AllShortestPaths[string, float64](s.roads, "C", "H", identityWeight)
+		s.NoError(err)
+		s.Len(paths, 2)
+	})
+
+	s.Run("same vertex", func() {
+		paths, err := AllShortestPaths[string, float64](s.roads, "C", "C", identityWeight)
+		s.NoError(err)
+		s.Equal([]Path[string]{{Nodes: []string{"C"}, Cost: 0}}, paths)
+	})
+
+	s.Run("unreachable", func() {
+		_, err := AllShortestPaths[string, float64](s.roads, "H", "C", identityWeight)
+		s.True(errors.Is(err, ErrNoPath))
+	})
+
+	s.Run("zero-weight cycle", func() {
+		g := NewDirectedGraph[string, float64]()
+		g.AddEdge("a", "b", 0)
+		g.AddEdge("b", "a", 0)
+		g.AddEdge("b", "t", 1)
+		g.AddEdge("a", "t", 1)
+		paths, err := AllShortestPaths[string, float64](g, "a", "t", identityWeight)
+		s.NoError(err)
+		nodes := [][]string{}
+		for _, p := range paths {
+			s.Equal(1.0, p.Cost)
+			nodes = append(nodes, p.Nodes)
+		}
+		s.ElementsMatch([][]string{{"a", "t"}, {"a", "b", "t"}}, nodes)
+
+		undirected := NewUndirectedGraph[int, float64]()
+		undirected.AddEdge(0, 1, 0)
+		undirected.AddEdge(1, 2, 0)
+		undirected.AddEdge(2, 0, 0)
+		undirected.AddEdge(2, 3, 2)
+		cycle, err := AllShortestPaths[int, float64](undirected, 0, 3, identityWeight)
+		s.NoError(err)
+		s.Len(cycle, 2)
+	})
+}
+
+func (s *PathsTestSuite) TestAllSimplePaths() {
+	s.Run("every route through a DAG", func() {
+		paths := [][]string{}
+		for p := range AllSimplePaths[string, float64](s.roads, "C", "H", 0) {
+			paths = append(paths, p)
+		}
+		s.Len(paths, 7)
+		s.Contains(paths, []string{"C", "D", "F", "G", "H"})
+	})
+
+	s.Run("depth limit", func() {
+		paths := [][]string{}
+		for p := range AllSimplePaths[string, float64](s.roads, "C", "H", 3) {
+			s.LessOrEqual(len(p), 4)
+			paths = append(paths, p)
+		}
+		s.ElementsMatch([][]string{{"C", "D", "F", "H"}, {"C", "E", "F", "H"}, {"C", "E", "G", "H"}}, paths)
+	})
+
+	s.Run("cycles are not repeated", func() {
+		g := undirectedFromEdges([][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}})
+		paths := [][]int{}
+		for p := range AllSimplePaths[int, struct{}](g, 0, 3, 0) {
+			paths = append(paths, p)
+		}
+		s.ElementsMatch([][]int{{0, 2, 3}, {0, 1, 2, 3}}, paths)
+	})
+
+	s.Run("early stop and missing vertices", func() {
+		count := 0
+		for range AllSimplePaths[string, float64](s.roads, "C", "H", 0) {
+			count++
+			break
+		}
+		s.Equal(1, count)
+
+		for range AllSimplePaths[string, float64](s.roads, "C", "Z", 0) {
+			s.Fail("unexpected path")
+		}
+	})
+}
+
+func TestPathsTestSuite(t *testing.T) {
+	suite.Run(t, new(PathsTestSuite))
+}
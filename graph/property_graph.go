@@ -0,0 +1,432 @@
+package graph
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// PropertyNode is a snapshot of a PropertyGraph vertex.
+type PropertyNode[N comparable] struct {
+	ID         N
+	Labels     []string
+	Properties Attributes
+}
+
+// HasLabel reports whether the node carries label.
+func (n PropertyNode[N]) HasLabel(label string) bool {
+	return slices.Contains(n.Labels, label)
+}
+
+// PropertyEdge is a snapshot of a PropertyGraph edge.
+type PropertyEdge[N comparable] struct {
+	ID         EdgeID
+	From       N
+	To         N
+	Label      string
+	Properties Attributes
+}
+
+type propertyVertex struct {
+	labels     []string
+	properties Attributes
+	out, in    []EdgeID
+}
+
+// PropertyGraph is a directed multigraph whose vertices carry any number of
+// labels and whose edges carry one label, both with key/value properties.
+// Property values are string, bool, int or float64, like Attributes; other
+// integer and float kinds are converted on the way in. Node properties can be
+// indexed for fast equality lookups.
+type PropertyGraph[N comparable] struct {
+	nodes   map[N]*propertyVertex
+	edges   map[EdgeID]*PropertyEdge[N]
+	labels  map[string]map[N]bool
+	indexes map[string]map[any]map[N]bool
+	nextID  EdgeID
+}
+
+func NewPropertyGraph[N comparable]() *PropertyGraph[N] {
+	return &PropertyGraph[N]{
+		nodes:   make(map[N]*propertyVertex),
+		edges:   make(map[EdgeID]*PropertyEdge[N]),
+		labels:  make(map[string]map[N]bool),
+		indexes: make(map[string]map[any]map[N]bool),
+	}
+}
+
+func normalizeProperties(props Attributes) (Attributes, error) {
+	normalized := make(Attributes, len(props))
+	for key, value := range props {
+		v, err := normalizeAttribute(value)
+		if err != nil {
+			return nil, fmt.Errorf("graph: property %q: %w", key, err)
+		}
+		normalized[key] = v
+	}
+	return normalized, nil
+}
+
+// AddNode adds id with the given properties and labels. If id already exists,
+// the labels are added to its own and the properties overwrite same-named
+// ones.
+func (pg *PropertyGraph[N]) AddNode(id N, props Attributes, labels ...string) error {
+	normalized, err := normalizeProperties(props)
+	if err != nil {
+		return err
+	}
+	pg.ensureNode(id)
+	for _, label := range labels {
+		pg.AddLabel(id, label)
+	}
+	for key, value := range normalized {
+		pg.setNodeProperty(id, key, value)
+	}
+	return nil
+}
+
+func (pg *PropertyGraph[N]) ensureNode(id N) *propertyVertex {
+	v, ok := pg.nodes[id]
+	if !ok {
+		v = &propertyVertex{properties: Attributes{}}
+		pg.nodes[id] = v
+	}
+	return v
+}
+
+// RemoveNode deletes id together with every edge that touches it.
+func (pg *PropertyGraph[N]) RemoveNode(id N) bool {
+	v, ok := pg.nodes[id]
+	if !ok {
+		return false
+	}
+	for _, edgeID := range slices.Concat(v.out, v.in) {
+		pg.RemoveEdge(edgeID)
+	}
+	for _, label := range v.labels {
+		pg.unindexLabel(id, label)
+	}
+	for key, value := range v.properties {
+		pg.unindexProperty(id, key, value)
+	}
+	delete(pg.nodes, id)
+	return true
+}
+
+func (pg *PropertyGraph[N]) HasNode(id N) bool {
+	_, ok := pg.nodes[id]
+	return ok
+}
+
+func (pg *PropertyGraph[N]) Node(id N) (PropertyNode[N], bool) {
+	v, ok := pg.nodes[id]
+	if !ok {
+		return PropertyNode[N]{}, false
+	}
+	return pg.snapshot(id, v), true
+}
+
+func (pg *PropertyGraph[N]) snapshot(id N, v *propertyVertex) PropertyNode[N] {
+	props := make(Attributes, len(v.properties))
+	for key, value := range v.properties {
+		props[key] = value
+	}
+	return PropertyNode[N]{ID: id, Labels: slices.Clone(v.labels), Properties: props}
+}
+
+// Nodes yields every node in no particular order.
+func (pg *PropertyGraph[N]) Nodes() iter.Seq[PropertyNode[N]] {
+	return func(yield func(PropertyNode[N]) bool) {
+		for id, v := range pg.nodes {
+			if !yield(pg.snapshot(id, v)) {
+				return
+			}
+		}
+	}
+}
+
+func (pg *PropertyGraph[N]) NodeCount() int {
+	return len(pg.nodes)
+}
+
+// AddLabel adds label to an existing node. It reports false if the node is
+// missing.
+func (pg *PropertyGraph[N]) AddLabel(id N, label string) bool {
+	v, ok := pg.nodes[id]
+	if !ok {
+		return false
+	}
+	if !slices.Contains(v.labels, label) {
+		v.labels = append(v.labels, label)
+		if pg.labels[label] == nil {
+			pg.labels[label] = make(map[N]bool)
+		}
+		pg.labels[label][id] = true
+	}
+	return true
+}
+
+func (pg *PropertyGraph[N]) RemoveLabel(id N, label string) bool {
+	v, ok := pg.nodes[id]
+	if !ok || !slices.Contains(v.labels, label) {
+		return false
+	}
+	v.labels = slices.DeleteFunc(v.labels, func(l string) bool { return l == label })
+	pg.unindexLabel(id, label)
+	return true
+}
+
+func (pg *PropertyGraph[N]) unindexLabel(id N, label string) {
+	delete(pg.labels[label], id)
+	if len(pg.labels[label]) == 0 {
+		delete(pg.labels, label)
+	}
+}
+
+// SetNodeProperty sets one property of an existing node.
+func (pg *PropertyGraph[N]) SetNodeProperty(id N, key string, value any) error {
+	if !pg.HasNode(id) {
+		return fmt.Errorf("graph: node %v not found", id)
+	}
+	v, err := normalizeAttribute(value)
+	if err != nil {
+		return fmt.Errorf("graph: property %q: %w", key, err)
+	}
+	pg.setNodeProperty(id, key, v)
+	return nil
+}
+
+func (pg *PropertyGraph[N]) setNodeProperty(id N, key string, value any) {
+	v := pg.nodes[id]
+	if old, ok := v.properties[key]; ok {
+		pg.unindexProperty(id, key, old)
+	}
+	v.properties[key] = value
+	if index, ok := pg.indexes[key]; ok {
+		if index[value] == nil {
+			index[value] = make(map[N]bool)
+		}
+		index[value][id] = true
+	}
+}
+
+func (pg *PropertyGraph[N]) RemoveNodeProperty(id N, key string) bool {
+	v, ok := pg.nodes[id]
+	if !ok {
+		return false
+	}
+	old, ok := v.properties[key]
+	if !ok {
+		return false
+	}
+	pg.unindexProperty(id, key, old)
+	delete(v.properties, key)
+	return true
+}
+
+func (pg *PropertyGraph[N]) unindexProperty(id N, key string, value any) {
+	index, ok := pg.indexes[key]
+	if !ok {
+		return
+	}
+	delete(index[value], id)
+	if len(index[value]) == 0 {
+		delete(index, value)
+	}
+}
+
+// CreateIndex indexes node property key so that MatchProperty and
+// NodesWithProperty find matching nodes without scanning the graph. Creating
+// an existing index is a no-op.
+func (pg *PropertyGraph[N]) CreateIndex(key string) {
+	if _, ok := pg.indexes[key]; ok {
+		return
+	}
+	index := make(map[any]map[N]bool)
+	for id, v := range pg.nodes {
+		if value, ok := v.properties[key]; ok {
+			if index[value] == nil {
+				index[value] = make(map[N]bool)
+			}
+			index[value][id] = true
+		}
+	}
+	pg.indexes[key] = index
+}
+
+func (pg *PropertyGraph[N]) DropIndex(key string) {
+	delete(pg.indexes, key)
+}
+
+func (pg *PropertyGraph[N]) HasIndex(key string) bool {
+	_, ok := pg.indexes[key]
+	return ok
+}
+
+// NodesWithLabel yields the nodes carrying label in no particular order.
+func (pg *PropertyGraph[N]) NodesWithLabel(label string) iter.Seq[PropertyNode[N]] {
+	return pg.nodeSet(func() map[N]bool { return pg.labels[label] })
+}
+
+// NodesWithProperty yields the nodes whose property key equals value, using
+// an index when one exists.
+func (pg *PropertyGraph[N]) NodesWithProperty(key string, value any) iter.Seq[PropertyNode[N]] {
+	v, err := normalizeAttribute(value)
+	if err != nil {
+		return func(func(PropertyNode[N]) bool) {}
+	}
+	return func(yield func(PropertyNode[N]) bool) {
+		if index, ok := pg.indexes[key]; ok {
+			pg.nodeSet(func() map[N]bool { return index[v] })(yield)
+			return
+		}
+		for id, node := range pg.nodes {
+			if found, ok := node.properties[key]; ok && found == v && !yield(pg.snapshot(id, node)) {
+				return
+			}
+		}
+	}
+}
+
+// nodeSet looks the set up only when iterated so that queries see later
+// changes to the graph.
+func (pg *PropertyGraph[N]) nodeSet(ids func() map[N]bool) iter.Seq[PropertyNode[N]] {
+	return func(yield func(PropertyNode[N]) bool) {
+		for id := range ids() {
+			// The loop body may have removed the node.
+			v, ok := pg.nodes[id]
+			if !ok {
+				continue
+			}
+			if !yield(pg.snapshot(id, v)) {
+				return
+			}
+		}
+	}
+}
+
+// AddEdge adds a labelled edge from one node to another, creating missing
+// nodes without labels, and returns its ID. Parallel edges are kept.
+func (pg *PropertyGraph[N]) AddEdge(from, to N, label string, props Attributes) (EdgeID, error) {
+	normalized, err := normalizeProperties(props)
+	if err != nil {
+		return 0, err
+	}
+	pg.nextID++
+	id := pg.nextID
+	pg.edges[id] = &PropertyEdge[N]{ID: id, From: from, To: to, Label: label, Properties: normalized}
+	source := pg.ensureNode(from)
+	source.out = append(source.out, id)
+	target := pg.ensureNode(to)
+	target.in = append(target.in, id)
+	return id, nil
+}
+
+func (pg *PropertyGraph[N]) RemoveEdge(id EdgeID) bool {
+	e, ok := pg.edges[id]
+	if !ok {
+		return false
+	}
+	without := func(ids []EdgeID) []EdgeID {
+		return slices.DeleteFunc(ids, func(other EdgeID) bool { return other == id })
+	}
+	pg.nodes[e.From].out = without(pg.nodes[e.From].out)
+	pg.nodes[e.To].in = without(pg.nodes[e.To].in)
+	delete(pg.edges, id)
+	return true
+}
+
+func (pg *PropertyGraph[N]) Edge(id EdgeID) (PropertyEdge[N], bool) {
+	e, ok := pg.edges[id]
+	if !ok {
+		return PropertyEdge[N]{}, false
+	}
+	return copyPropertyEdge(e), true
+}
+
+func copyPropertyEdge[N comparable](e *PropertyEdge[N]) PropertyEdge[N] {
+	c := *e
+	c.Properties = make(Attributes, len(e.Properties))
+	for key, value := range e.Properties {
+		c.Properties[key] = value
+	}
+	return c
+}
+
+func (pg *PropertyGraph[N]) SetEdgeProperty(id EdgeID, key string, value any) error {
+	e, ok := pg.edges[id]
+	if !ok {
+		return fmt.Errorf("graph: edge %d not found", id)
+	}
+	v, err := normalizeAttribute(value)
+	if err != nil {
+		return fmt.Errorf("graph: property %q: %w", key, err)
+	}
+	e.Properties[key] = v
+	return nil
+}
+
+func (pg *PropertyGraph[N]) RemoveEdgeProperty(id EdgeID, key string) bool {
+	e, ok := pg.edges[id]
+	if !ok {
+		return false
+	}
+	if _, ok := e.Properties[key]; !ok {
+		return false
+	}
+	delete(e.Properties, key)
+	return true
+}
+
+// Edges yields every edge in no particular order.
+func (pg *PropertyGraph[N]) Edges() iter.Seq[PropertyEdge[N]] {
+	return func(yield func(PropertyEdge[N]) bool) {
+		for _, e := range pg.edges {
+			if !yield(copyPropertyEdge(e)) {
+				return
+			}
+		}
+	}
+}
+
+func (pg *PropertyGraph[N]) EdgeCount() int {
+	return len(pg.edges)
+}
+
+// OutEdges yields the edges leaving id in insertion order, restricted to the
+// given labels when any are passed.
+func (pg *PropertyGraph[N]) OutEdges(id N, labels ...string) iter.Seq[PropertyEdge[N]] {
+	return pg.incident(id, true, labels)
+}
+
+// InEdges yields the edges entering id in insertion order, restricted to the
+// given labels when any are passed.
+func (pg *PropertyGraph[N]) InEdges(id N, labels ...string) iter.Seq[PropertyEdge[N]] {
+	return pg.incident(id, false, labels)
+}
+
+func (pg *PropertyGraph[N]) incident(id N, out bool, labels []string) iter.Seq[PropertyEdge[N]] {
+	return func(yield func(PropertyEdge[N]) bool) {
+		v, ok := pg.nodes[id]
+		if !ok {
+			return
+		}
+		ids := v.in
+		if out {
+			ids = v.out
+		}
+		for _, edgeID := range slices.Clone(ids) {
+			// The loop body may have removed the edge.
+			e, ok := pg.edges[edgeID]
+			if !ok {
+				continue
+			}
+			if len(labels) > 0 && !slices.Contains(labels, e.Label) {
+				continue
+			}
+			if !yield(copyPropertyEdge(e)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PropertyGraphTestSuite struct {
+	suite.Suite
+	pg *PropertyGraph[string]
+}
+
+func (s *PropertyGraphTestSuite) SetupTest() {
+	s.pg = NewPropertyGraph[string]()
+}
+
+func collect[T any](seq func(func(T) bool)) []T {
+	out := []T{}
+	for v := range seq {
+		out = append(out, v)
+	}
+	return out
+}
+
+func nodeIDs[N comparable](nodes []PropertyNode[N]) []N {
+	ids := make([]N, len(nodes))
+	for i, n := range nodes {
+		ids[i] = n.ID
+	}
+	return ids
+}
+
+func (s *PropertyGraphTestSuite) TestAddNode() {
+	s.Run("labels and normalized properties", func() {
+		s.NoError(s.pg.AddNode("alice", Attributes{"age": int64(30), "score": float32(1.5)}, "Person", "Employee"))
+		node, ok := s.pg.Node("alice")
+		s.True(ok)
+		s.Equal([]string{"Person", "Employee"}, node.Labels)
+		s.Equal(Attributes{"age": 30, "score": 1.5}, node.Properties)
+		s.True(node.HasLabel("Employee"))
+	})
+
+	s.Run("adding again merges", func() {
+		s.NoError(s.pg.AddNode("alice", Attributes{"age": 31, "city": "Oslo"}, "Person", "Admin"))
+		node, _ := s.pg.Node("alice")
+		s.Equal([]string{"Person", "Employee", "Admin"}, node.Labels)
+		s.Equal(Attributes{"age": 31, "score": 1.5, "city": "Oslo"}, node.Properties)
+		s.Equal(1, s.pg.NodeCount())
+	})
+
+	s.Run("unsupported property type", func() {
+		s.Error(s.pg.AddNode("bob", Attributes{"tags": []string{"x"}}))
+		s.False(s.pg.HasNode("bob"))
+	})
+
+	s.Run("snapshots are copies", func() {
+		node, _ := s.pg.Node("alice")
+		node.Properties["age"] = 99
+		node.Labels[0] = "Robot"
+		again, _ := s.pg.Node("alice")
+		s.Equal(31, again.Properties["age"])
+		s.Equal("Person", again.Labels[0])
+	})
+}
+
+func (s *PropertyGraphTestSuite) TestTypedProperties() {
+	s.NoError(s.pg.AddNode("alice", Attributes{"age": 30, "name": "Alice", "active": true}))
+	node, _ := s.pg.Node("alice")
+
+	age, ok := AttributeValue[int](node.Properties, "age")
+	s.True(ok)
+	s.Equal(30, age)
+
+	_, ok = AttributeValue[string](node.Properties, "age")
+	s.False(ok)
+
+	active, ok := AttributeValue[bool](node.Properties, "active")
+	s.True(ok)
+	s.True(active)
+
+	_, ok = AttributeValue[string](node.Properties, "missing")
+	s.False(ok)
+}
+
+func (s *PropertyGraphTestSuite) TestLabelsAndProperties() {
+	s.NoError(s.pg.AddNode("alice", nil, "Person"))
+
+	s.True(s.pg.AddLabel("alice", "Admin"))
+	s.False(s.pg.AddLabel("bob", "Admin"))
+	s.ElementsMatch([]string{"alice"}, nodeIDs(collect(s.pg.NodesWithLabel("Admin"))))
+
+	s.True(s.pg.RemoveLabel("alice", "Admin"))
+	s.False(s.pg.RemoveLabel("alice", "Admin"))
+	s.Empty(collect(s.pg.NodesWithLabel("Admin")))
+
+	s.NoError(s.pg.SetNodeProperty("alice", "age", 30))
+	s.Error(s.pg.SetNodeProperty("bob", "age", 30))
+	s.Error(s.pg.SetNodeProperty("alice", "tags", []int{1}))
+
+	s.True(s.pg.RemoveNodeProperty("alice", "age"))
+	s.False(s.pg.RemoveNodeProperty("alice", "age"))
+}
+
+func (s *PropertyGraphTestSuite) TestIndexes() {
+	s.NoError(s.pg.AddNode("alice", Attributes{"city": "Oslo"}, "Person"))
+	s.NoError(s.pg.AddNode("bob", Attributes{"city": "Paris"}, "Person"))
+	s.NoError(s.pg.AddNode("carol", Attributes{"city": "Oslo"}, "Person"))
+
+	s.Run("lookup without an index scans", func() {
+		s.False(s.pg.HasIndex("city"))
+		s.ElementsMatch([]string{"alice", "carol"}, nodeIDs(collect(s.pg.NodesWithProperty("city", "Oslo"))))
+	})
+
+	s.Run("index covers existing nodes", func() {
+		s.pg.CreateIndex("city")
+		s.True(s.pg.HasIndex("city"))
+		s.ElementsMatch([]string{"alice", "carol"}, nodeIDs(collect(s.pg.NodesWithProperty("city", "Oslo"))))
+	})
+
+	s.Run("index follows updates", func() {
+		s.NoError(s.pg.SetNodeProperty("bob", "city", "Oslo"))
+		s.NoError(s.pg.SetNodeProperty("alice", "city", "Rome"))
+		s.True(s.pg.RemoveNodeProperty("carol", "city"))
+		s.NoError(s.pg.AddNode("dave", Attributes{"city": "Oslo"}))
+		s.True(s.pg.RemoveNode("dave"))
+		s.ElementsMatch([]string{"bob"}, nodeIDs(collect(s.pg.NodesWithProperty("city", "Oslo"))))
+		s.ElementsMatch([]string{"alice"}, nodeIDs(collect(s.pg.NodesWithProperty("city", "Rome"))))
+	})
+
+	s.Run("values are typed", func() {
+		s.NoError(s.pg.SetNodeProperty("alice", "floor", 3))
+		s.pg.CreateIndex("floor")
+		s.Len(collect(s.pg.NodesWithProperty("floor", int8(3))), 1)
+		s.Empty(collect(s.pg.NodesWithProperty("floor", 3.0)))
+		s.Empty(collect(s.pg.NodesWithProperty("floor", "3")))
+	})
+
+	s.Run("drop index", func() {
+		s.pg.DropIndex("city")
+		s.False(s.pg.HasIndex("city"))
+		s.ElementsMatch([]string{"bob"}, nodeIDs(collect(s.pg.NodesWithProperty("city", "Oslo"))))
+	})
+}
+
+func (s *PropertyGraphTestSuite) TestEdges() {
+	s.NoError(s.pg.AddNode("alice", nil, "Person"))
+	knows, err := s.pg.AddEdge("alice", "bob", "KNOWS", Attributes{"since": 2019})
+	s.NoError(err)
+	likes, err := s.pg.AddEdge("alice", "bob", "LIKES", nil)
+	s.NoError(err)
+	follows, err := s.pg.AddEdge("carol", "alice", "FOLLOWS", nil)
+	s.NoError(err)
+
+	s.Run("missing endpoints are created", func() {
+		s.True(s.pg.HasNode("bob"))
+		bob, _ := s.pg.Node("bob")
+		s.Empty(bob.Labels)
+		s.Equal(3, s.pg.NodeCount())
+		s.Equal(3, s.pg.EdgeCount())
+	})
+
+	s.Run("parallel edges keep their labels", func() {
+		e, ok := s.pg.Edge(knows)
+		s.True(ok)
+		s.Equal(PropertyEdge[string]{ID: knows, From: "alice", To: "bob", Label: "KNOWS", Properties: Attributes{"since": 2019}}, e)
+		e, _ = s.pg.Edge(likes)
+		s.Equal("LIKES", e.Label)
+	})
+
+	s.Run("incident edges in insertion order", func() {
+		out := collect(s.pg.OutEdges("alice"))
+		s.Equal([]EdgeID{knows, likes}, []EdgeID{out[0].ID, out[1].ID})
+		s.Len(collect(s.pg.OutEdges("alice", "LIKES")), 1)
+		in := collect(s.pg.InEdges("alice"))
+		s.Len(in, 1)
+		s.Equal(follows, in[0].ID)
+		s.Empty(collect(s.pg.OutEdges("nobody")))
+	})
+
+	s.Run("edge properties", func() {
+		s.NoError(s.pg.SetEdgeProperty(likes, "weight", float32(0.5)))
+		e, _ := s.pg.Edge(likes)
+		s.Equal(Attributes{"weight": 0.5}, e.Properties)
+		s.Error(s.pg.SetEdgeProperty(EdgeID(999), "weight", 1))
+		s.True(s.pg.RemoveEdgeProperty(likes, "weight"))
+		s.False(s.pg.RemoveEdgeProperty(likes, "weight"))
+	})
+
+	s.Run("remove edge", func() {
+		s.True(s.pg.RemoveEdge(likes))
+		s.False(s.pg.RemoveEdge(likes))
+		s.Len(collect(s.pg.OutEdges("alice")), 1)
+		s.Equal(2, s.pg.EdgeCount())
+	})
+
+	s.Run("remove edges while iterating", func() {
+		loop, err := s.pg.AddEdge("alice", "carol", "KNOWS", nil)
+		s.NoError(err)
+		seen := []EdgeID{}
+		for e := range s.pg.OutEdges("alice") {
+			seen = append(seen, e.ID)
+			s.pg.RemoveEdge(loop)
+		}
+		s.Equal([]EdgeID{knows}, seen)
+		s.Len(collect(s.pg.OutEdges("alice")), 1)
+	})
+
+	s.Run("remove nodes while iterating", func() {
+		pg := NewPropertyGraph[int]()
+		for i := 0; i < 10; i++ {
+			s.NoError(pg.AddNode(i, nil, "Person"))
+		}
+		seen := 0
+		for n := range pg.NodesWithLabel("Person") {
+			seen++
+			for i := 0; i < 10; i++ {
+				if i != n.ID {
+					pg.RemoveNode(i)
+				}
+			}
+		}
+		s.Equal(1, seen)
+		s.Equal(1, pg.NodeCount())
+	})
+
+	s.Run("remove node drops its edges", func() {
+		s.True(s.pg.RemoveNode("alice"))
+		s.False(s.pg.RemoveNode("alice"))
+		s.Equal(0, s.pg.EdgeCount())
+		s.Empty(collect(s.pg.InEdges("bob")))
+		s.Empty(collect(s.pg.OutEdges("carol")))
+		s.Empty(collect(s.pg.NodesWithLabel("Person")))
+	})
+
+	s.Run("all edges", func() {
+		s.Empty(collect(s.pg.Edges()))
+		_, err := s.pg.AddEdge("bob", "carol", "KNOWS", Attributes{"bad": slices.Values([]int{})})
+		s.Error(err)
+	})
+}
+
+func TestPropertyGraphTestSuite(t *testing.T) {
+	suite.Run(t, new(PropertyGraphTestSuite))
+}
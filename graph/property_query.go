@@ -0,0 +1,222 @@
+package graph
+
+import (
+	"iter"
+	"slices"
+)
+
+// PropertyQuery is a lazily evaluated pipeline over the nodes of a
+// PropertyGraph. Every step returns a new query and nothing runs until one of
+// the result methods, such as Nodes or Count, is iterated. The graph must not
+// be modified while a result is being iterated.
+type PropertyQuery[N comparable] struct {
+	graph *PropertyGraph[N]
+	seq   iter.Seq[PropertyNode[N]]
+}
+
+// Match starts a query at the nodes that carry every given label, or at all
+// nodes when no label is given.
+func (pg *PropertyGraph[N]) Match(labels ...string) *PropertyQuery[N] {
+	if len(labels) == 0 {
+		return &PropertyQuery[N]{graph: pg, seq: pg.Nodes()}
+	}
+	smallest := labels[0]
+	for _, label := range labels[1:] {
+		if len(pg.labels[label]) < len(pg.labels[smallest]) {
+			smallest = label
+		}
+	}
+	q := &PropertyQuery[N]{graph: pg, seq: pg.NodesWithLabel(smallest)}
+	for _, label := range labels {
+		if label != smallest {
+			q = q.HasLabel(label)
+		}
+	}
+	return q
+}
+
+// MatchProperty starts a query at the nodes whose property key equals value,
+// using an index when one exists.
+func (pg *PropertyGraph[N]) MatchProperty(key string, value any) *PropertyQuery[N] {
+	return &PropertyQuery[N]{graph: pg, seq: pg.NodesWithProperty(key, value)}
+}
+
+// Where keeps the nodes accepted by keep.
+func (q *PropertyQuery[N]) Where(keep func(PropertyNode[N]) bool) *PropertyQuery[N] {
+	return &PropertyQuery[N]{graph: q.graph, seq: func(yield func(PropertyNode[N]) bool) {
+		for node := range q.seq {
+			if keep(node) && !yield(node) {
+				return
+			}
+		}
+	}}
+}
+
+func (q *PropertyQuery[N]) HasLabel(label string) *PropertyQuery[N] {
+	return q.Where(func(node PropertyNode[N]) bool { return node.HasLabel(label) })
+}
+
+// Has keeps the nodes whose property key equals value.
+func (q *PropertyQuery[N]) Has(key string, value any) *PropertyQuery[N] {
+	v, err := normalizeAttribute(value)
+	return q.Where(func(node PropertyNode[N]) bool {
+		found, ok := node.Properties[key]
+		return err == nil && ok && found == v
+	})
+}
+
+// Out moves to the targets of the outgoing edges with any of the given
+// labels, or with any label when none is given. A node reached by several
+// edges appears once per edge; use Distinct to collapse them.
+func (q *PropertyQuery[N]) Out(labels ...string) *PropertyQuery[N] {
+	return q.OutWhere(edgeLabelFilter[N](labels))
+}
+
+// In moves to the sources of the incoming edges, like Out.
+func (q *PropertyQuery[N]) In(labels ...string) *PropertyQuery[N] {
+	return q.InWhere(edgeLabelFilter[N](labels))
+}
+
+// Both follows edges in either direction, like Out and In combined.
+func (q *PropertyQuery[N]) Both(labels ...string) *PropertyQuery[N] {
+	follow := edgeLabelFilter[N](labels)
+	return q.traverse(func(node PropertyNode[N], yield func(PropertyNode[N]) bool) bool {
+		return q.step(node.ID, true, follow, yield) && q.step(node.ID, false, follow, yield)
+	})
+}
+
+// OutWhere moves to the targets of the outgoing edges accepted by follow.
+func (q *PropertyQuery[N]) OutWhere(follow func(PropertyEdge[N]) bool) *PropertyQuery[N] {
+	return q.traverse(func(node PropertyNode[N], yield func(PropertyNode[N]) bool) bool {
+		return q.step(node.ID, true, follow, yield)
+	})
+}
+
+// InWhere moves to the sources of the incoming edges accepted by follow.
+func (q *PropertyQuery[N]) InWhere(follow func(PropertyEdge[N]) bool) *PropertyQuery[N] {
+	return q.traverse(func(node PropertyNode[N], yield func(PropertyNode[N]) bool) bool {
+		return q.step(node.ID, false, follow, yield)
+	})
+}
+
+func edgeLabelFilter[N comparable](labels []string) func(PropertyEdge[N]) bool {
+	return func(e PropertyEdge[N]) bool {
+		return len(labels) == 0 || slices.Contains(labels, e.Label)
+	}
+}
+
+func (q *PropertyQuery[N]) traverse(expand func(PropertyNode[N], func(PropertyNode[N]) bool) bool) *PropertyQuery[N] {
+	return &PropertyQuery[N]{graph: q.graph, seq: func(yield func(PropertyNode[N]) bool) {
+		for node := range q.seq {
+			if !expand(node, yield) {
+				return
+			}
+		}
+	}}
+}
+
+func (q *PropertyQuery[N]) step(id N, out bool, follow func(PropertyEdge[N]) bool, yield func(PropertyNode[N]) bool) bool {
+	for e := range q.graph.incident(id, out, nil) {
+		if !follow(e) {
+			continue
+		}
+		next := e.From
+		if out {
+			next = e.To
+		}
+		if node, ok := q.graph.Node(next); ok && !yield(node) {
+			return false
+		}
+	}
+	return true
+}
+
+// Distinct drops nodes that were already produced.
+func (q *PropertyQuery[N]) Distinct() *PropertyQuery[N] {
+	return &PropertyQuery[N]{graph: q.graph, seq: func(yield func(PropertyNode[N]) bool) {
+		seen := make(map[N]bool)
+		for node := range q.seq {
+			if seen[node.ID] {
+				continue
+			}
+			seen[node.ID] = true
+			if !yield(node) {
+				return
+			}
+		}
+	}}
+}
+
+// Limit stops after n nodes.
+func (q *PropertyQuery[N]) Limit(n int) *PropertyQuery[N] {
+	return &PropertyQuery[N]{graph: q.graph, seq: func(yield func(PropertyNode[N]) bool) {
+		if n <= 0 {
+			return
+		}
+		count := 0
+		for node := range q.seq {
+			count++
+			if !yield(node) || count >= n {
+				return
+			}
+		}
+	}}
+}
+
+func (q *PropertyQuery[N]) Nodes() iter.Seq[PropertyNode[N]] {
+	return q.seq
+}
+
+func (q *PropertyQuery[N]) IDs() iter.Seq[N] {
+	return func(yield func(N) bool) {
+		for node := range q.seq {
+			if !yield(node.ID) {
+				return
+			}
+		}
+	}
+}
+
+// Values yields property key of every node that has it.
+func (q *PropertyQuery[N]) Values(key string) iter.Seq[any] {
+	return func(yield func(any) bool) {
+		for node := range q.seq {
+			if v, ok := node.Properties[key]; ok && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Project yields, for every node, the subset of its properties named by keys.
+// Missing properties are left out of the result.
+func (q *PropertyQuery[N]) Project(keys ...string) iter.Seq[Attributes] {
+	return func(yield func(Attributes) bool) {
+		for node := range q.seq {
+			row := make(Attributes, len(keys))
+			for _, key := range keys {
+				if v, ok := node.Properties[key]; ok {
+					row[key] = v
+				}
+			}
+			if !yield(row) {
+				return
+			}
+		}
+	}
+}
+
+func (q *PropertyQuery[N]) Count() int {
+	count := 0
+	for range q.seq {
+		count++
+	}
+	return count
+}
+
+func (q *PropertyQuery[N]) First() (PropertyNode[N], bool) {
+	for node := range q.seq {
+		return node, true
+	}
+	return PropertyNode[N]{}, false
+}
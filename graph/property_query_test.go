@@ -0,0 +1,127 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PropertyQueryTestSuite struct {
+	suite.Suite
+	pg *PropertyGraph[string]
+}
+
+func (s *PropertyQueryTestSuite) SetupTest() {
+	s.pg = NewPropertyGraph[string]()
+	people := []struct {
+		id   string
+		age  int
+		city string
+	}{
+		{"alice", 30, "Oslo"}, {"bob", 25, "Paris"}, {"carol", 35, "Oslo"}, {"dave", 40, "Rome"},
+	}
+	for _, p := range people {
+		s.Require().NoError(s.pg.AddNode(p.id, Attributes{"age": p.age, "city": p.city}, "Person"))
+	}
+	s.Require().NoError(s.pg.AddNode("acme", Attributes{"name": "Acme"}, "Company"))
+	s.Require().NoError(s.pg.AddNode("dave", nil, "Manager"))
+
+	for _, e := range []struct{ from, to, label string }{
+		{"alice", "bob", "KNOWS"}, {"alice", "carol", "KNOWS"}, {"bob", "carol", "KNOWS"},
+		{"alice", "acme", "WORKS_AT"}, {"carol", "acme", "WORKS_AT"}, {"dave", "acme", "MANAGES"},
+	} {
+		_, err := s.pg.AddEdge(e.from, e.to, e.label, nil)
+		s.Require().NoError(err)
+	}
+}
+
+func (s *PropertyQueryTestSuite) ids(q *PropertyQuery[string]) []string {
+	return collect(q.IDs())
+}
+
+func (s *PropertyQueryTestSuite) TestMatch() {
+	s.ElementsMatch([]string{"alice", "bob", "carol", "dave"}, s.ids(s.pg.Match("Person")))
+	s.ElementsMatch([]string{"dave"}, s.ids(s.pg.Match("Person", "Manager")))
+	s.Equal(5, s.pg.Match().Count())
+	s.Equal(0, s.pg.Match("Robot").Count())
+
+	s.ElementsMatch([]string{"alice", "carol"}, s.ids(s.pg.MatchProperty("city", "Oslo")))
+	s.pg.CreateIndex("city")
+	s.ElementsMatch([]string{"alice", "carol"}, s.ids(s.pg.MatchProperty("city", "Oslo")))
+}
+
+func (s *PropertyQueryTestSuite) TestFilter() {
+	over30 := func(n PropertyNode[string]) bool {
+		age, _ := AttributeValue[int](n.Properties, "age")
+		return age > 30
+	}
+	s.ElementsMatch([]string{"carol", "dave"}, s.ids(s.pg.Match("Person").Where(over30)))
+	s.ElementsMatch([]string{"alice", "carol"}, s.ids(s.pg.Match().Has("city", "Oslo")))
+	s.ElementsMatch([]string{"dave"}, s.ids(s.pg.Match("Person").HasLabel("Manager")))
+}
+
+func (s *PropertyQueryTestSuite) TestTraverse() {
+	s.Run("out by label", func() {
+		s.ElementsMatch([]string{"bob", "carol"}, s.ids(s.pg.MatchProperty("age", 30).Out("KNOWS")))
+		s.ElementsMatch([]string{"bob", "carol", "acme"}, s.ids(s.pg.MatchProperty("age", 30).Out()))
+	})
+
+	s.Run("in and distinct", func() {
+		// Colleagues of alice: everyone working where she works.
+		colleagues := s.pg.MatchProperty("age", 30).Out("WORKS_AT").In("WORKS_AT")
+		s.ElementsMatch([]string{"alice", "carol"}, s.ids(colleagues))
+
+		friendsOfFriends := s.pg.Match("Person").Out("KNOWS").Out("KNOWS")
+		s.Equal([]string{"carol"}, s.ids(friendsOfFriends))
+
+		reached := s.pg.Match("Person").Out("KNOWS")
+		s.Equal(3, reached.Count())
+		s.Equal(2, reached.Distinct().Count())
+	})
+
+	s.Run("both directions", func() {
+		s.ElementsMatch([]string{"alice", "carol"}, s.ids(s.pg.MatchProperty("age", 25).Both("KNOWS")))
+	})
+
+	s.Run("edge predicate", func() {
+		managers := s.pg.Match("Company").InWhere(func(e PropertyEdge[string]) bool { return e.Label == "MANAGES" })
+		s.Equal([]string{"dave"}, s.ids(managers))
+		s.Equal(0, s.pg.Match("Company").OutWhere(func(PropertyEdge[string]) bool { return true }).Count())
+	})
+}
+
+func (s *PropertyQueryTestSuite) TestResults() {
+	s.Run("values and projection", func() {
+		s.ElementsMatch([]any{"Oslo", "Oslo", "Paris", "Rome"}, collect(s.pg.Match("Person").Values("city")))
+		rows := collect(s.pg.Match("Company", "Person").Project("name"))
+		s.Empty(rows)
+		rows = collect(s.pg.MatchProperty("city", "Paris").Project("age", "name"))
+		s.Equal([]Attributes{{"age": 25}}, rows)
+	})
+
+	s.Run("limit and first", func() {
+		s.Equal(2, s.pg.Match("Person").Limit(2).Count())
+		s.Equal(0, s.pg.Match("Person").Limit(0).Count())
+		node, ok := s.pg.Match("Company").First()
+		s.True(ok)
+		s.Equal("acme", node.ID)
+		_, ok = s.pg.Match("Robot").First()
+		s.False(ok)
+	})
+
+	s.Run("queries are lazy and reusable", func() {
+		q := s.pg.Match("Company")
+		s.Equal(1, q.Count())
+		s.NoError(s.pg.AddNode("globex", nil, "Company"))
+		s.Equal(2, q.Count())
+		s.Len(collect(q.Nodes()), 2)
+
+		robots := s.pg.Match("Robot")
+		s.NoError(s.pg.AddNode("r2", nil, "Robot"))
+		s.Equal(1, robots.Count())
+	})
+}
+
+func TestPropertyQueryTestSuite(t *testing.T) {
+	suite.Run(t, new(PropertyQueryTestSuite))
+}
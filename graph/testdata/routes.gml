@@ -0,0 +1,46 @@
+Creator "yFiles"
+Version "2.2"
+graph
+[
+  directed 0
+  node
+  [
+    id 0
+    label "Paris"
+    country "FR"
+    graphics
+    [
+      x 10.0
+      y 20.0
+    ]
+  ]
+  node
+  [
+    id 1
+    label "Lyon"
+    country "FR"
+  ]
+  node
+  [
+    id 2
+    label "Geneva"
+    country "CH"
+  ]
+  # Gephi writes integer weights without a decimal point
+  edge
+  [
+    source 0
+    target 1
+    distance 465.5
+    mode "rail"
+    toll 0
+  ]
+  edge
+  [
+    source 1
+    target 2
+    distance 150
+    mode "road &amp; bridge"
+    toll 1
+  ]
+]
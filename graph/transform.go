@@ -0,0 +1,198 @@
+package graph
+
+func (g *genericAdjacencyListGraph[N, E]) clone() *genericAdjacencyListGraph[N, E] {
+	c := newGenericAdjacencyListGraph[N, E]()
+	for from, neighbors := range g.adj {
+		c.addVertex(from)
+		for to, edge := range neighbors {
+			c.adj[from][to] = edge
+		}
+	}
+	return c
+}
+
+func (g *genericAdjacencyListGraph[N, E]) transpose() *genericAdjacencyListGraph[N, E] {
+	t := newGenericAdjacencyListGraph[N, E]()
+	for from, neighbors := range g.adj {
+		t.addVertex(from)
+		for to, edge := range neighbors {
+			t.addVertex(to)
+			t.adj[to][from] = edge
+		}
+	}
+	return t
+}
+
+func (g *genericAdjacencyListGraph[N, E]) inducedSubgraph(nodes []N) *genericAdjacencyListGraph[N, E] {
+	sub := newGenericAdjacencyListGraph[N, E]()
+	for _, node := range nodes {
+		if g.hasVertex(node) {
+			sub.addVertex(node)
+		}
+	}
+	for from := range sub.adj {
+		for to, edge := range g.adj[from] {
+			if sub.hasVertex(to) {
+				sub.adj[from][to] = edge
+			}
+		}
+	}
+	return sub
+}
+
+// edgeSubgraph keeps the edges accepted by keep together with their endpoints.
+// For undirected graphs keep is asked once per edge.
+func (g *genericAdjacencyListGraph[N, E]) edgeSubgraph(directed bool, keep func(from, to N, edge E) bool) *genericAdjacencyListGraph[N, E] {
+	sub := newGenericAdjacencyListGraph[N, E]()
+	seen := make(map[[2]N]bool)
+	for from, neighbors := range g.adj {
+		for to, edge := range neighbors {
+			if !directed {
+				if seen[[2]N{to, from}] {
+					continue
+				}
+				seen[[2]N{from, to}] = true
+			}
+			if !keep(from, to, edge) {
+				continue
+			}
+			sub.addVertex(from)
+			sub.addVertex(to)
+			sub.adj[from][to] = edge
+			if !directed {
+				sub.adj[to][from] = edge
+			}
+		}
+	}
+	return sub
+}
+
+// union keeps the receiver's payload for edges present in both graphs.
+func (g *genericAdjacencyListGraph[N, E]) union(other *genericAdjacencyListGraph[N, E]) *genericAdjacencyListGraph[N, E] {
+	u := g.clone()
+	for from, neighbors := range other.adj {
+		u.addVertex(from)
+		for to, edge := range neighbors {
+			u.addVertex(to)
+			if !u.hasEdge(from, to) {
+				u.adj[from][to] = edge
+			}
+		}
+	}
+	return u
+}
+
+func (g *genericAdjacencyListGraph[N, E]) intersection(other *genericAdjacencyListGraph[N, E]) *genericAdjacencyListGraph[N, E] {
+	in := newGenericAdjacencyListGraph[N, E]()
+	for from, neighbors := range g.adj {
+		if !other.hasVertex(from) {
+			continue
+		}
+		in.addVertex(from)
+		for to, edge := range neighbors {
+			if other.hasEdge(from, to) {
+				in.addVertex(to)
+				in.adj[from][to] = edge
+			}
+		}
+	}
+	return in
+}
+
+// difference keeps every vertex of the receiver and only the edges that other
+// does not have.
+func (g *genericAdjacencyListGraph[N, E]) difference(other *genericAdjacencyListGraph[N, E]) *genericAdjacencyListGraph[N, E] {
+	d := newGenericAdjacencyListGraph[N, E]()
+	for from, neighbors := range g.adj {
+		d.addVertex(from)
+		for to, edge := range neighbors {
+			if !other.hasEdge(from, to) {
+				d.addVertex(to)
+				d.adj[from][to] = edge
+			}
+		}
+	}
+	return d
+}
+
+// complement connects every pair of distinct vertices that is not connected in
+// the receiver, asking newEdge for the payload. Self-loops are never added.
+func (g *genericAdjacencyListGraph[N, E]) complement(directed bool, newEdge func(from, to N) E) *genericAdjacencyListGraph[N, E] {
+	c := newGenericAdjacencyListGraph[N, E]()
+	for v := range g.adj {
+		c.addVertex(v)
+	}
+	for from := range g.adj {
+		for to := range g.adj {
+			if from == to || g.hasEdge(from, to) || c.hasEdge(from, to) {
+				continue
+			}
+			edge := newEdge(from, to)
+			c.adj[from][to] = edge
+			if !directed {
+				c.adj[to][from] = edge
+			}
+		}
+	}
+	return c
+}
+
+func (dg *DirectedGraph[N, E]) Clone() *DirectedGraph[N, E] {
+	return &DirectedGraph[N, E]{g: dg.g.clone()}
+}
+
+func (dg *DirectedGraph[N, E]) Transpose() *DirectedGraph[N, E] {
+	return &DirectedGraph[N, E]{g: dg.g.transpose()}
+}
+
+func (dg *DirectedGraph[N, E]) InducedSubgraph(nodes []N) *DirectedGraph[N, E] {
+	return &DirectedGraph[N, E]{g: dg.g.inducedSubgraph(nodes)}
+}
+
+func (dg *DirectedGraph[N, E]) EdgeSubgraph(keep func(from, to N, edge E) bool) *DirectedGraph[N, E] {
+	return &DirectedGraph[N, E]{g: dg.g.edgeSubgraph(true, keep)}
+}
+
+func (dg *DirectedGraph[N, E]) Union(other *DirectedGraph[N, E]) *DirectedGraph[N, E] {
+	return &DirectedGraph[N, E]{g: dg.g.union(other.g)}
+}
+
+func (dg *DirectedGraph[N, E]) Intersection(other *DirectedGraph[N, E]) *DirectedGraph[N, E] {
+	return &DirectedGraph[N, E]{g: dg.g.intersection(other.g)}
+}
+
+func (dg *DirectedGraph[N, E]) Difference(other *DirectedGraph[N, E]) *DirectedGraph[N, E] {
+	return &DirectedGraph[N, E]{g: dg.g.difference(other.g)}
+}
+
+func (dg *DirectedGraph[N, E]) Complement(newEdge func(from, to N) E) *DirectedGraph[N, E] {
+	return &DirectedGraph[N, E]{g: dg.g.complement(true, newEdge)}
+}
+
+func (ug *UndirectedGraph[N, E]) Clone() *UndirectedGraph[N, E] {
+	return &UndirectedGraph[N, E]{g: ug.g.clone()}
+}
+
+func (ug *UndirectedGraph[N, E]) InducedSubgraph(nodes []N) *UndirectedGraph[N, E] {
+	return &UndirectedGraph[N, E]{g: ug.g.inducedSubgraph(nodes)}
+}
+
+func (ug *UndirectedGraph[N, E]) EdgeSubgraph(keep func(from, to N, edge E) bool) *UndirectedGraph[N, E] {
+	return &UndirectedGraph[N, E]{g: ug.g.edgeSubgraph(false, keep)}
+}
+
+func (ug *UndirectedGraph[N, E]) Union(other *UndirectedGraph[N, E]) *UndirectedGraph[N, E] {
+	return &UndirectedGraph[N, E]{g: ug.g.union(other.g)}
+}
+
+func (ug *UndirectedGraph[N, E]) Intersection(other *UndirectedGraph[N, E]) *UndirectedGraph[N, E] {
+	return &UndirectedGraph[N, E]{g: ug.g.intersection(other.g)}
+}
+
+func (ug *UndirectedGraph[N, E]) Difference(other *UndirectedGraph[N, E]) *UndirectedGraph[N, E] {
+	return &UndirectedGraph[N, E]{g: ug.g.difference(other.g)}
+}
+
+func (ug *UndirectedGraph[N, E]) Complement(newEdge func(from, to N) E) *UndirectedGraph[N, E] {
+	return &UndirectedGraph[N, E]{g: ug.g.complement(false, newEdge)}
+}
@@ -0,0 +1,132 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TransformTestSuite struct {
+	suite.Suite
+	dg *DirectedGraph[string, int]
+	ug *UndirectedGraph[string, int]
+}
+
+func (s *TransformTestSuite) SetupTest() {
+	s.dg = NewDirectedGraph[string, int]()
+	s.dg.AddEdge("a", "b", 1)
+	s.dg.AddEdge("b", "c", 2)
+	s.dg.AddEdge("c", "a", 3)
+	s.dg.AddVertex("d")
+
+	s.ug = NewUndirectedGraph[string, int]()
+	s.ug.AddEdge("a", "b", 1)
+	s.ug.AddEdge("b", "c", 2)
+	s.ug.AddVertex("d")
+}
+
+func (s *TransformTestSuite) TestCloneIsIndependent() {
+	dc := s.dg.Clone()
+	dc.AddEdge("d", "a", 4)
+	dc.RemoveEdge("a", "b")
+	s.True(s.dg.HasEdge("a", "b"))
+	s.False(s.dg.HasEdge("d", "a"))
+
+	uc := s.ug.Clone()
+	s.ElementsMatch(s.ug.Vertices(), uc.Vertices())
+	uc.RemoveVertex("b")
+	s.True(s.ug.HasEdge("c", "b"))
+}
+
+func (s *TransformTestSuite) TestTranspose() {
+	t := s.dg.Transpose()
+	s.True(t.HasEdge("b", "a"))
+	s.False(t.HasEdge("a", "b"))
+	w, _ := t.Edge("a", "c")
+	s.Equal(3, w)
+	s.True(t.HasVertex("d"))
+}
+
+func (s *TransformTestSuite) TestInducedSubgraph() {
+	sub := s.dg.InducedSubgraph([]string{"a", "b", "missing"})
+	s.ElementsMatch([]string{"a", "b"}, sub.Vertices())
+	s.True(sub.HasEdge("a", "b"))
+	s.Len(sub.Edges(), 1)
+
+	usub := s.ug.InducedSubgraph([]string{"b", "c", "d"})
+	s.True(usub.HasEdge("c", "b"))
+	s.False(usub.HasVertex("a"))
+	s.Len(usub.Edges(), 1)
+}
+
+func (s *TransformTestSuite) TestEdgeSubgraph() {
+	sub := s.dg.EdgeSubgraph(func(from, to string, w int) bool { return w >= 2 })
+	s.ElementsMatch([]string{"a", "b", "c"}, sub.Vertices())
+	s.False(sub.HasEdge("a", "b"))
+	s.True(sub.HasEdge("b", "c"))
+
+	calls := 0
+	usub := s.ug.EdgeSubgraph(func(from, to string, w int) bool {
+		calls++
+		return w == 1
+	})
+	s.Equal(2, calls)
+	s.True(usub.HasEdge("b", "a"))
+	s.ElementsMatch([]string{"a", "b"}, usub.Vertices())
+}
+
+func (s *TransformTestSuite) TestSetOperations() {
+	other := NewDirectedGraph[string, int]()
+	other.AddEdge("a", "b", 10)
+	other.AddEdge("c", "e", 5)
+
+	u := s.dg.Union(other)
+	s.ElementsMatch([]string{"a", "b", "c", "d", "e"}, u.Vertices())
+	s.Len(u.Edges(), 4)
+	w, _ := u.Edge("a", "b")
+	s.Equal(1, w)
+
+	in := s.dg.Intersection(other)
+	s.ElementsMatch([]string{"a", "b", "c"}, in.Vertices())
+	s.Len(in.Edges(), 1)
+	s.True(in.HasEdge("a", "b"))
+
+	d := s.dg.Difference(other)
+	s.ElementsMatch(s.dg.Vertices(), d.Vertices())
+	s.False(d.HasEdge("a", "b"))
+	s.True(d.HasEdge("b", "c"))
+
+	uother := NewUndirectedGraph[string, int]()
+	uother.AddEdge("b", "a", 7)
+	uu := s.ug.Union(uother)
+	s.Len(uu.Edges(), 2)
+	ud := s.ug.Difference(uother)
+	s.False(ud.HasEdge("b", "a"))
+	s.True(ud.HasEdge("c", "b"))
+	ui := s.ug.Intersection(uother)
+	s.True(ui.HasEdge("a", "b"))
+	s.True(ui.HasEdge("b", "a"))
+}
+
+func (s *TransformTestSuite) TestComplement() {
+	c := s.dg.Complement(func(from, to string) int { return 0 })
+	s.Len(c.Edges(), 4*3-3)
+	s.False(c.HasEdge("a", "b"))
+	s.True(c.HasEdge("b", "a"))
+	s.False(c.HasEdge("a", "a"))
+
+	calls := 0
+	uc := s.ug.Complement(func(from, to string) int {
+		calls++
+		return 9
+	})
+	s.Equal(4, calls)
+	s.Len(uc.Edges(), 4)
+	s.True(uc.HasEdge("c", "a"))
+	s.True(uc.HasEdge("a", "c"))
+	s.False(uc.HasEdge("a", "b"))
+}
+
+func TestTransformTestSuite(t *testing.T) {
+	suite.Run(t, new(TransformTestSuite))
+}
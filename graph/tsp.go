@@ -0,0 +1,287 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+)
+
+// maxExactMatchingVertices bounds the odd-degree vertex count for which
+// Christofides computes an exact minimum-weight matching; larger sets fall
+// back to greedy matching.
+const maxExactMatchingVertices = 20
+
+// Tour is a closed route that visits each vertex once. Nodes does not repeat
+// the start vertex at the end, but Cost includes the edge back to it.
+type Tour[N comparable] struct {
+	Nodes []N
+	Cost  float64
+}
+
+// TSPNearestNeighbor builds a tour from start by always moving to the closest
+// unvisited neighbour. It fails with ErrNoPath when it gets stuck or cannot
+// return to start.
+func TSPNearestNeighbor[N comparable, E any](g ReadOnlyGraph[N, E], start N, weight func(E) float64) (Tour[N], error) {
+	ig := newIndexedGraph[N, E](g)
+	s, ok := ig.index[start]
+	if !ok {
+		return Tour[N]{}, fmt.Errorf("graph: start vertex %v not found", start)
+	}
+	w := weightMatrix(g, ig, weight)
+	visited := make([]bool, ig.size())
+	visited[s] = true
+	route := []int{s}
+	for len(route) < ig.size() {
+		current := route[len(route)-1]
+		next := -1
+		for v := range visited {
+			if !visited[v] && !math.IsInf(w[current][v], 1) && (next == -1 || w[current][v] < w[current][next]) {
+				next = v
+			}
+		}
+		if next == -1 {
+			return Tour[N]{}, fmt.Errorf("%w: stuck at vertex %v", ErrNoPath, ig.nodes[current])
+		}
+		visited[next] = true
+		route = append(route, next)
+	}
+	return makeTour(ig, w, route)
+}
+
+// TSPTwoOpt improves tour by reversing segments while that shortens it, until
+// no reversal helps. Each pass tries all O(n²) reversals and prices each in
+// O(1). The tour must list every vertex of g once.
+func TSPTwoOpt[N comparable, E any](g ReadOnlyGraph[N, E], tour []N, weight func(E) float64) (Tour[N], error) {
+	ig := newIndexedGraph[N, E](g)
+	if len(tour) != ig.size() {
+		return Tour[N]{}, fmt.Errorf("graph: tour has %d vertices, graph has %d", len(tour), ig.size())
+	}
+	route := make([]int, len(tour))
+	seen := make(map[int]bool, len(tour))
+	for i, node := range tour {
+		v, ok := ig.index[node]
+		if !ok || seen[v] {
+			return Tour[N]{}, fmt.Errorf("graph: tour vertex %v is unknown or repeated", node)
+		}
+		seen[v] = true
+		route[i] = v
+	}
+	w := weightMatrix(g, ig, weight)
+	n := len(route)
+	for improved := true; improved; {
+		improved = false
+		for i := 1; i < n-1; i++ {
+			// forward and backward hold the cost of route[i..j] walked in each
+			// direction, so each candidate is priced in O(1); for undirected
+			// graphs they are equal and the delta is the usual four-edge one.
+			forward, backward := 0.0, 0.0
+			for j := i + 1; j < n; j++ {
+				forward += w[route[j-1]][route[j]]
+				backward += w[route[j]][route[j-1]]
+				a, b, c, d := route[i-1], route[i], route[j], route[(j+1)%n]
+				before := w[a][b] + w[c][d] + forward
+				after := w[a][c] + w[b][d] + backward
+				if !math.IsInf(after, 1) && after < before-1e-12 {
+					reverse(route[i : j+1])
+					forward, backward = backward, forward
+					improved = true
+				}
+			}
+		}
+	}
+	return makeTour(ig, w, route)
+}
+
+// TSPChristofides builds a tour on a complete undirected graph whose weights
+// satisfy the triangle inequality. With an exact matching step, used when at
+// most 20 vertices of the spanning tree have odd degree, the tour costs at most
+// 1.5 times the optimum. It fails with ErrDirected on a directed graph.
+func TSPChristofides[N comparable, E any](g ReadOnlyGraph[N, E], start N, weight func(E) float64) (Tour[N], error) {
+	if err := requireUndirected(g, "Christofides"); err != nil {
+		return Tour[N]{}, err
+	}
+	ig := newIndexedGraph[N, E](g)
+	s, ok := ig.index[start]
+	if !ok {
+		return Tour[N]{}, fmt.Errorf("graph: start vertex %v not found", start)
+	}
+	n := ig.size()
+	w := weightMatrix[N, E](g, ig, weight)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if math.IsInf(w[i][j], 1) {
+				return Tour[N]{}, fmt.Errorf("graph: Christofides needs a complete graph, %v and %v are not adjacent", ig.nodes[i], ig.nodes[j])
+			}
+		}
+	}
+	if n == 1 {
+		return makeTour(ig, w, []int{s})
+	}
+
+	edges := minimumSpanningTree(w)
+	degree := make([]int, n)
+	for _, e := range edges {
+		degree[e[0]]++
+		degree[e[1]]++
+	}
+	odd := []int{}
+	for v, d := range degree {
+		if d%2 == 1 {
+			odd = append(odd, v)
+		}
+	}
+	edges = append(edges, minimumWeightMatching(w, odd)...)
+
+	visited := make([]bool, n)
+	route := []int{}
+	for _, v := range hierholzer(n, edges, false, s) {
+		if !visited[v] {
+			visited[v] = true
+			route = append(route, v)
+		}
+	}
+	return makeTour(ig, w, route)
+}
+
+// weightMatrix holds weight(edge) for each edge of g and +Inf elsewhere.
+func weightMatrix[N comparable, E any](g ReadOnlyGraph[N, E], ig *indexedGraph[N], weight func(E) float64) [][]float64 {
+	w := make([][]float64, ig.size())
+	for i := range w {
+		w[i] = make([]float64, ig.size())
+		for j := range w[i] {
+			w[i][j] = math.Inf(1)
+		}
+		for _, j := range ig.adj[i] {
+			edge, _ := g.Edge(ig.nodes[i], ig.nodes[j])
+			w[i][j] = weight(edge)
+		}
+	}
+	return w
+}
+
+func routeCost(w [][]float64, route []int) float64 {
+	if len(route) < 2 {
+		return 0
+	}
+	cost := 0.0
+	for i := range route {
+		cost += w[route[i]][route[(i+1)%len(route)]]
+	}
+	return cost
+}
+
+func makeTour[N comparable](ig *indexedGraph[N], w [][]float64, route []int) (Tour[N], error) {
+	cost := routeCost(w, route)
+	if math.IsInf(cost, 1) {
+		return Tour[N]{}, fmt.Errorf("%w: tour uses a missing edge", ErrNoPath)
+	}
+	nodes := make([]N, len(route))
+	for i, v := range route {
+		nodes[i] = ig.nodes[v]
+	}
+	return Tour[N]{Nodes: nodes, Cost: cost}, nil
+}
+
+func reverse(s []int) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// minimumSpanningTree runs Prim's algorithm on a dense weight matrix.
+func minimumSpanningTree(w [][]float64) [][2]int {
+	n := len(w)
+	inTree := make([]bool, n)
+	best := make([]float64, n)
+	parent := make([]int, n)
+	for i := range best {
+		best[i] = math.Inf(1)
+		parent[i] = -1
+	}
+	best[0] = 0
+	edges := [][2]int{}
+	for range w {
+		u := -1
+		for v := range w {
+			if !inTree[v] && (u == -1 || best[v] < best[u]) {
+				u = v
+			}
+		}
+		inTree[u] = true
+		if parent[u] >= 0 {
+			edges = append(edges, [2]int{parent[u], u})
+		}
+		for v := range w {
+			if !inTree[v] && w[u][v] < best[v] {
+				best[v] = w[u][v]
+				parent[v] = u
+			}
+		}
+	}
+	return edges
+}
+
+// minimumWeightMatching pairs up vertices (an even number of them) with the
+// smallest total weight, exactly for small sets and greedily otherwise.
+func minimumWeightMatching(w [][]float64, vertices []int) [][2]int {
+	k := len(vertices)
+	if k == 0 {
+		return nil
+	}
+	if k > maxExactMatchingVertices {
+		return greedyMatching(w, vertices)
+	}
+	full := 1<<k - 1
+	cost := make([]float64, 1<<k)
+	choice := make([]int, 1<<k)
+	for mask := 1; mask <= full; mask++ {
+		cost[mask] = math.Inf(1)
+	}
+	for mask := 1; mask <= full; mask++ {
+		i := 0
+		for mask&(1<<i) == 0 {
+			i++
+		}
+		for j := i + 1; j < k; j++ {
+			if mask&(1<<j) == 0 {
+				continue
+			}
+			rest := mask &^ (1<<i | 1<<j)
+			if c := cost[rest] + w[vertices[i]][vertices[j]]; c < cost[mask] {
+				cost[mask] = c
+				choice[mask] = j
+			}
+		}
+	}
+	pairs := [][2]int{}
+	for mask := full; mask != 0; {
+		i := 0
+		for mask&(1<<i) == 0 {
+			i++
+		}
+		j := choice[mask]
+		pairs = append(pairs, [2]int{vertices[i], vertices[j]})
+		mask &^= 1<<i | 1<<j
+	}
+	return pairs
+}
+
+func greedyMatching(w [][]float64, vertices []int) [][2]int {
+	matched := make(map[int]bool, len(vertices))
+	pairs := [][2]int{}
+	for len(matched) < len(vertices) {
+		a, b := -1, -1
+		for x, u := range vertices {
+			for _, v := range vertices[x+1:] {
+				if matched[u] || matched[v] {
+					continue
+				}
+				if a == -1 || w[u][v] < w[a][b] {
+					a, b = u, v
+				}
+			}
+		}
+		matched[a], matched[b] = true, true
+		pairs = append(pairs, [2]int{a, b})
+	}
+	return pairs
+}
@@ -0,0 +1,165 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type point struct{ x, y float64 }
+
+func euclidean(a, b point) float64 {
+	return math.Hypot(a.x-b.x, a.y-b.y)
+}
+
+func completeEuclidean(points []point) *UndirectedGraph[point, float64] {
+	g := NewUndirectedGraph[point, float64]()
+	for i, a := range points {
+		for _, b := range points[i+1:] {
+			g.AddEdge(a, b, euclidean(a, b))
+		}
+	}
+	return g
+}
+
+func identityWeight(w float64) float64 { return w }
+
+type TSPTestSuite struct {
+	suite.Suite
+	points []point
+	g      *UndirectedGraph[point, float64]
+}
+
+func (s *TSPTestSuite) SetupTest() {
+	// Corners and edge midpoints of a 2x2 square; the optimal tour is its
+	// perimeter of length 8.
+	s.points = []point{{0, 0}, {1, 0}, {2, 0}, {2, 1}, {2, 2}, {1, 2}, {0, 2}, {0, 1}}
+	s.g = completeEuclidean(s.points)
+}
+
+func (s *TSPTestSuite) isTour(t Tour[point]) {
+	s.ElementsMatch(s.points, t.Nodes)
+	cost := 0.0
+	for i := range t.Nodes {
+		cost += euclidean(t.Nodes[i], t.Nodes[(i+1)%len(t.Nodes)])
+	}
+	s.InDelta(cost, t.Cost, 1e-9)
+}
+
+func (s *TSPTestSuite) TestNearestNeighbor() {
+	tour, err := TSPNearestNeighbor[point, float64](s.g, point{0, 0}, identityWeight)
+	s.NoError(err)
+	s.Equal(point{0, 0}, tour.Nodes[0])
+	s.isTour(tour)
+	s.InDelta(8, tour.Cost, 1e-9)
+
+	_, err = TSPNearestNeighbor[point, float64](s.g, point{9, 9}, identityWeight)
+	s.Error(err)
+}
+
+func (s *TSPTestSuite) TestTwoOpt() {
+	crossed := []point{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {1, 0}, {2, 1}, {1, 2}, {0, 1}}
+	before := routeCostOf(crossed)
+	tour, err := TSPTwoOpt[point, float64](s.g, crossed, identityWeight)
+	s.NoError(err)
+	s.isTour(tour)
+	s.Less(tour.Cost, before)
+	s.InDelta(8, tour.Cost, 1e-9)
+
+	_, err = TSPTwoOpt[point, float64](s.g, crossed[:3], identityWeight)
+	s.Error(err)
+}
+
+func (s *TSPTestSuite) TestTwoOptDirected() {
+	// Going round 0→1→2→3 is cheap and the reverse direction is expensive, so
+	// a reversal must be priced with the segment's backward edges.
+	g := NewDirectedGraph[int, float64]()
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if i != j {
+				if j == (i+1)%4 {
+					g.AddEdge(i, j, 1)
+				} else {
+					g.AddEdge(i, j, 10)
+				}
+			}
+		}
+	}
+	tour, err := TSPTwoOpt[int, float64](g, []int{0, 2, 1, 3}, identityWeight)
+	s.NoError(err)
+	s.Equal(4.0, tour.Cost)
+	s.Equal([]int{0, 1, 2, 3}, tour.Nodes)
+
+	tour, err = TSPTwoOpt[int, float64](g, []int{0, 1, 2, 3}, identityWeight)
+	s.NoError(err)
+	s.Equal([]int{0, 1, 2, 3}, tour.Nodes)
+}
+
+func (s *TSPTestSuite) TestTwoOptIsLocallyOptimal() {
+	points := []point{}
+	for i := 0; i < 30; i++ {
+		points = append(points, point{float64((i * 37) % 101), float64((i * 59) % 97)})
+	}
+	g := completeEuclidean(points)
+	tour, err := TSPTwoOpt[point, float64](g, points, identityWeight)
+	s.NoError(err)
+	s.InDelta(routeCostOf(tour.Nodes), tour.Cost, 1e-9)
+	s.Less(tour.Cost, routeCostOf(points))
+	for i := 1; i < len(points)-1; i++ {
+		for j := i + 1; j < len(points); j++ {
+			candidate := append([]point{}, tour.Nodes...)
+			for l, r := i, j; l < r; l, r = l+1, r-1 {
+				candidate[l], candidate[r] = candidate[r], candidate[l]
+			}
+			s.GreaterOrEqual(routeCostOf(candidate), tour.Cost-1e-9)
+		}
+	}
+}
+
+func (s *TSPTestSuite) TestChristofides() {
+	tour, err := TSPChristofides[point, float64](s.g, point{0, 0}, identityWeight)
+	s.NoError(err)
+	s.Equal(point{0, 0}, tour.Nodes[0])
+	s.isTour(tour)
+	s.LessOrEqual(tour.Cost, 1.5*8+1e-9)
+
+	frozen, err := TSPChristofides[point, float64](s.g.Freeze(), point{0, 0}, identityWeight)
+	s.NoError(err)
+	s.isTour(frozen)
+	s.LessOrEqual(frozen.Cost, 1.5*8+1e-9)
+	directed := NewDirectedGraph[point, float64]()
+	directed.AddEdge(point{0, 0}, point{1, 1}, 1)
+	_, err = TSPChristofides[point, float64](directed, point{0, 0}, identityWeight)
+	s.ErrorIs(err, ErrDirected)
+
+	s.g.RemoveEdge(point{0, 0}, point{2, 2})
+	_, err = TSPChristofides[point, float64](s.g, point{0, 0}, identityWeight)
+	s.Error(err)
+}
+
+func (s *TSPTestSuite) TestIncompleteGraph() {
+	g := NewDirectedGraph[int, float64]()
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	_, err := TSPNearestNeighbor[int, float64](g, 0, identityWeight)
+	s.True(errors.Is(err, ErrNoPath))
+	g.AddEdge(2, 0, 1)
+	tour, err := TSPNearestNeighbor[int, float64](g, 0, identityWeight)
+	s.NoError(err)
+	s.Equal([]int{0, 1, 2}, tour.Nodes)
+	s.Equal(3.0, tour.Cost)
+}
+
+func routeCostOf(route []point) float64 {
+	cost := 0.0
+	for i := range route {
+		cost += euclidean(route[i], route[(i+1)%len(route)])
+	}
+	return cost
+}
+
+func TestTSPTestSuite(t *testing.T) {
+	suite.Run(t, new(TSPTestSuite))
+}
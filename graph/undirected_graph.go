@@ -1,7 +1,9 @@
 package graph
 
 type UndirectedGraph[N comparable, E any] struct {
-	g *genericAdjacencyListGraph[N, E]
+	g      *genericAdjacencyListGraph[N, E]
+	conn   *connectivityIndex[N]
+	events *eventHub[N, E]
 }
 
 func NewUndirectedGraph[N comparable, E any]() *UndirectedGraph[N, E] {
@@ -9,19 +11,43 @@ func NewUndirectedGraph[N comparable, E any]() *UndirectedGraph[N, E] {
 }
 
 func (ug *UndirectedGraph[N, E]) AddVertex(node N) {
+	events := ug.events.vertexAddition(ug.g, node)
 	ug.g.addVertex(node)
+	if ug.conn != nil {
+		ug.conn.vertexAdded(node)
+	}
+	ug.events.emit(events...)
 }
 
 func (ug *UndirectedGraph[N, E]) RemoveVertex(node N) {
+	events := ug.events.vertexRemoval(ug.g, node, false)
+	if ug.conn != nil {
+		for _, neighbor := range ug.g.neighbors(node) {
+			ug.g.removeEdge(node, neighbor)
+			splitAfterEdgeRemoval(ug.conn, ug.g, node, neighbor)
+		}
+		ug.conn.vertexRemoved(node)
+	}
 	ug.g.removeVertex(node)
+	ug.events.emit(events...)
 }
 
 func (ug *UndirectedGraph[N, E]) AddEdge(from, to N, edge E) {
+	events := ug.events.edgeAddition(ug.g, from, to, edge)
 	ug.g.addEdge(from, to, edge)
+	if ug.conn != nil {
+		ug.conn.edgeAdded(from, to)
+	}
+	ug.events.emit(events...)
 }
 
 func (ug *UndirectedGraph[N, E]) RemoveEdge(from, to N) {
+	events := ug.events.edgeRemoval(ug.g, from, to)
 	ug.g.removeEdge(from, to)
+	if ug.conn != nil {
+		splitAfterEdgeRemoval(ug.conn, ug.g, from, to)
+	}
+	ug.events.emit(events...)
 }
 
 func (ug *UndirectedGraph[N, E]) Neighbors(node N) []N {
@@ -36,6 +62,14 @@ func (ug *UndirectedGraph[N, E]) HasEdge(from, to N) bool {
 	return ug.g.hasEdge(from, to)
 }
 
+func (ug *UndirectedGraph[N, E]) Edge(from, to N) (E, bool) {
+	return ug.g.edge(from, to)
+}
+
+func (ug *UndirectedGraph[N, E]) IsDirected() bool {
+	return false
+}
+
 func (ug *UndirectedGraph[N, E]) Vertices() []N {
 	return ug.g.vertices()
 }
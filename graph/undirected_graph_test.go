@@ -42,6 +42,13 @@ func (s *UndirectedGraphTestSuite) TestUndirectedGraph() {
 		s.Equal([]int{2}, neighbors1)
 		s.Equal([]int{1}, neighbors2)
 	})
+	s.Run("Edge", func() {
+		s.g.AddEdge(1, 2, testEdgeUndirected{label: "a-b"})
+		edge, ok := s.g.Edge(2, 1)
+		s.True(ok)
+		s.Equal("a-b", edge.label)
+		s.False(s.g.IsDirected())
+	})
 	s.Run("RemoveEdge", func() {
 		s.g.AddVertex(1)
 		s.g.AddVertex(2)
@@ -0,0 +1,419 @@
+package interval
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// Interval is the closed range [Low, High].
+type Interval[K any] struct {
+	Low  K
+	High K
+}
+
+type entry[K any, V any] struct {
+	interval Interval[K]
+	value    V
+}
+
+// node is keyed by its own entry. The nodes also form a priority search tree
+// on High: held is the entry with the largest High among those in the subtree
+// that no ancestor holds, and an own entry that loses to held is parked at its
+// node instead. So every entry sits on the path from the root to its node,
+// and a nil held means the subtree stores nothing.
+type node[K any, V any] struct {
+	entry  *entry[K, V]
+	held   *entry[K, V]
+	parked bool
+	left   *node[K, V]
+	right  *node[K, V]
+	height int
+}
+
+// IntervalTree maps closed intervals to values and finds every interval that
+// overlaps a point or another interval. It is an AVL tree ordered by Low and
+// then High that doubles as a priority search tree on High, so a query that
+// matches k intervals costs O(log n + k) in the worst case. Insert takes
+// O(log n) and Delete O(log² n), since each rotation repairs the priority
+// search tree along one path. It is not safe for concurrent use, and the tree
+// must not be modified while one of its iterators is running.
+type IntervalTree[K any, V any] struct {
+	root    *node[K, V]
+	size    int
+	compare func(a, b K) int
+}
+
+func NewIntervalTree[K cmp.Ordered, V any]() *IntervalTree[K, V] {
+	return NewIntervalTreeFunc[K, V](cmp.Compare[K])
+}
+
+// NewIntervalTreeFunc orders endpoints with compare, which returns a negative
+// number, zero or a positive number as a is less than, equal to or greater
+// than b.
+func NewIntervalTreeFunc[K any, V any](compare func(a, b K) int) *IntervalTree[K, V] {
+	return &IntervalTree[K, V]{compare: compare}
+}
+
+func (n *node[K, V]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *node[K, V]) update() {
+	n.height = max(n.left.getHeight(), n.right.getHeight()) + 1
+}
+
+func (t *IntervalTree[K, V]) compareIntervals(a, b Interval[K]) int {
+	if c := t.compare(a.Low, b.Low); c != 0 {
+		return c
+	}
+	return t.compare(a.High, b.High)
+}
+
+// higher reports whether a ranks above b in the priority search tree.
+func (t *IntervalTree[K, V]) higher(a, b *entry[K, V]) bool {
+	return b == nil || a != nil && t.compare(a.interval.High, b.interval.High) > 0
+}
+
+// pushDown hands e, whose node lies in the subtree of n, to that subtree. It
+// keeps the higher of e and n.held and passes the other down towards its own
+// node.
+func (t *IntervalTree[K, V]) pushDown(n *node[K, V], e *entry[K, V]) {
+	for e != nil {
+		if t.higher(e, n.held) {
+			n.held, e = e, n.held
+			if e == nil {
+				return
+			}
+		}
+		switch c := t.compareIntervals(e.interval, n.entry.interval); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			n.parked = true
+			return
+		}
+	}
+}
+
+// pullUp refills the empty slot of n from its parked entry or its children.
+func (t *IntervalTree[K, V]) pullUp(n *node[K, V]) {
+	for n != nil {
+		var best *entry[K, V]
+		if n.parked {
+			best = n.entry
+		}
+		var from *node[K, V]
+		for _, child := range []*node[K, V]{n.left, n.right} {
+			if child != nil && t.higher(child.held, best) {
+				best, from = child.held, child
+			}
+		}
+		n.held = best
+		if from == nil {
+			n.parked = n.parked && best != n.entry
+			return
+		}
+		from.held = nil
+		n = from
+	}
+}
+
+// extract takes the entry of target out of the priority search tree.
+func (t *IntervalTree[K, V]) extract(target *node[K, V]) {
+	e := target.entry
+	for n := t.root; n != target; {
+		if n.held == e {
+			n.held = nil
+			t.pullUp(n)
+			return
+		}
+		if t.compareIntervals(e.interval, n.entry.interval) < 0 {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	if target.held == e {
+		target.held = nil
+		t.pullUp(target)
+		return
+	}
+	target.parked = false
+}
+
+// rotateRight and rotateLeft move the two held entries out of the way, then
+// give the higher one to the new subtree root and push the other back down.
+func (t *IntervalTree[K, V]) rotateRight(n *node[K, V]) *node[K, V] {
+	l := n.left
+	top, other := n.held, l.held
+	n.held, l.held = nil, nil
+	n.left = l.right
+	l.right = n
+	n.update()
+	l.update()
+	t.pullUp(n)
+	l.held = top
+	t.pushDown(l, other)
+	return l
+}
+
+func (t *IntervalTree[K, V]) rotateLeft(n *node[K, V]) *node[K, V] {
+	r := n.right
+	top, other := n.held, r.held
+	n.held, r.held = nil, nil
+	n.right = r.left
+	r.left = n
+	n.update()
+	r.update()
+	t.pullUp(n)
+	r.held = top
+	t.pushDown(r, other)
+	return r
+}
+
+// balance restores the AVL invariant at n after one of its subtrees changed
+// height by at most one.
+func (t *IntervalTree[K, V]) balance(n *node[K, V]) *node[K, V] {
+	n.update()
+	switch diff := n.left.getHeight() - n.right.getHeight(); {
+	case diff > 1:
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = t.rotateLeft(n.left)
+		}
+		return t.rotateRight(n)
+	case diff < -1:
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = t.rotateRight(n.right)
+		}
+		return t.rotateLeft(n)
+	}
+	return n
+}
+
+func (t *IntervalTree[K, V]) find(iv Interval[K]) *node[K, V] {
+	n := t.root
+	for n != nil {
+		switch c := t.compareIntervals(iv, n.entry.interval); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// Insert stores value under iv and reports whether iv is new. It fails if
+// iv.Low is greater than iv.High.
+func (t *IntervalTree[K, V]) Insert(iv Interval[K], value V) (bool, error) {
+	if t.compare(iv.Low, iv.High) > 0 {
+		return false, fmt.Errorf("invalid interval: low %v is greater than high %v", iv.Low, iv.High)
+	}
+	if n := t.find(iv); n != nil {
+		n.entry.value = value
+		return false, nil
+	}
+	// The new entry joins the priority search tree once the shape is settled.
+	e := &entry[K, V]{interval: iv, value: value}
+	t.root = t.insert(t.root, e)
+	t.pushDown(t.root, e)
+	t.size++
+	return true, nil
+}
+
+func (t *IntervalTree[K, V]) insert(n *node[K, V], e *entry[K, V]) *node[K, V] {
+	if n == nil {
+		return &node[K, V]{entry: e, height: 1}
+	}
+	if t.compareIntervals(e.interval, n.entry.interval) < 0 {
+		n.left = t.insert(n.left, e)
+	} else {
+		n.right = t.insert(n.right, e)
+	}
+	return t.balance(n)
+}
+
+// Delete removes iv and reports whether it was present.
+func (t *IntervalTree[K, V]) Delete(iv Interval[K]) bool {
+	target := t.find(iv)
+	if target == nil {
+		return false
+	}
+	t.extract(target)
+	var moved *entry[K, V]
+	t.root = t.delete(t.root, iv, &moved)
+	if moved != nil {
+		t.pushDown(t.root, moved)
+	}
+	t.size--
+	return true
+}
+
+// delete unlinks the node of iv, whose entry is already extracted. A node with
+// two children takes over the entry of its successor instead, which is
+// extracted as well and returned in *moved for the caller to push back.
+func (t *IntervalTree[K, V]) delete(n *node[K, V], iv Interval[K], moved **entry[K, V]) *node[K, V] {
+	switch c := t.compareIntervals(iv, n.entry.interval); {
+	case c < 0:
+		n.left = t.delete(n.left, iv, moved)
+	case c > 0:
+		n.right = t.delete(n.right, iv, moved)
+	default:
+		if n.left == nil || n.right == nil {
+			return t.unlink(n)
+		}
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		t.extract(successor)
+		n.entry = successor.entry
+		*moved = n.entry
+		n.right = t.removeMin(n.right)
+	}
+	return t.balance(n)
+}
+
+// removeMin unlinks the smallest node of the subtree.
+func (t *IntervalTree[K, V]) removeMin(n *node[K, V]) *node[K, V] {
+	if n.left == nil {
+		return t.unlink(n)
+	}
+	n.left = t.removeMin(n.left)
+	return t.balance(n)
+}
+
+// unlink replaces n, which has at most one child and whose own entry is
+// extracted, by that child.
+func (t *IntervalTree[K, V]) unlink(n *node[K, V]) *node[K, V] {
+	child := n.left
+	if child == nil {
+		child = n.right
+	}
+	if n.held != nil {
+		t.pushDown(child, n.held)
+	}
+	return child
+}
+
+func (t *IntervalTree[K, V]) Get(iv Interval[K]) (V, bool) {
+	if n := t.find(iv); n != nil {
+		return n.entry.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (t *IntervalTree[K, V]) Contains(iv Interval[K]) bool {
+	return t.find(iv) != nil
+}
+
+func (t *IntervalTree[K, V]) Len() int {
+	return t.size
+}
+
+func (t *IntervalTree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+func (t *IntervalTree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// All yields every interval in ascending order of Low, then High.
+func (t *IntervalTree[K, V]) All() iter.Seq2[Interval[K], V] {
+	return func(yield func(Interval[K], V) bool) {
+		var walk func(n *node[K, V]) bool
+		walk = func(n *node[K, V]) bool {
+			return n == nil || walk(n.left) && yield(n.entry.interval, n.entry.value) && walk(n.right)
+		}
+		walk(t.root)
+	}
+}
+
+// Overlapping yields every stored interval that shares at least one point
+// with query, in no particular order. Endpoints are inclusive, so [1, 3]
+// overlaps [3, 5]. An invalid query yields nothing.
+func (t *IntervalTree[K, V]) Overlapping(query Interval[K]) iter.Seq2[Interval[K], V] {
+	return func(yield func(Interval[K], V) bool) {
+		if t.compare(query.Low, query.High) > 0 {
+			return
+		}
+		t.overlapping(t.root, query, yield)
+	}
+}
+
+// OverlappingPoint yields every stored interval that contains point.
+func (t *IntervalTree[K, V]) OverlappingPoint(point K) iter.Seq2[Interval[K], V] {
+	return t.Overlapping(Interval[K]{Low: point, High: point})
+}
+
+func (t *IntervalTree[K, V]) overlaps(a, b Interval[K]) bool {
+	return t.compare(a.Low, b.High) <= 0 && t.compare(b.Low, a.High) <= 0
+}
+
+// overlapping stops at a node whose held entry ends before query.Low, since
+// nothing below it reaches further, and only goes right of a node that starts
+// no later than query.High. Every other node it visits either yields or lies
+// on the search path of query.High.
+func (t *IntervalTree[K, V]) overlapping(n *node[K, V], query Interval[K], yield func(Interval[K], V) bool) bool {
+	if n == nil || n.held == nil || t.compare(n.held.interval.High, query.Low) < 0 {
+		return true
+	}
+	if t.overlaps(n.held.interval, query) && !yield(n.held.interval, n.held.value) {
+		return false
+	}
+	if n.parked && t.overlaps(n.entry.interval, query) && !yield(n.entry.interval, n.entry.value) {
+		return false
+	}
+	if !t.overlapping(n.left, query, yield) {
+		return false
+	}
+	if t.compare(n.entry.interval.Low, query.High) > 0 {
+		return true
+	}
+	return t.overlapping(n.right, query, yield)
+}
+
+// MergeOverlaps returns the union of intervals as a sorted list of disjoint
+// intervals. Intervals that overlap or touch are merged; intervals with Low
+// greater than High are ignored. The input is not modified.
+func MergeOverlaps[K cmp.Ordered](intervals []Interval[K]) []Interval[K] {
+	return MergeOverlapsFunc(intervals, cmp.Compare[K])
+}
+
+// MergeOverlapsFunc is MergeOverlaps with endpoints ordered by compare.
+func MergeOverlapsFunc[K any](intervals []Interval[K], compare func(a, b K) int) []Interval[K] {
+	sorted := make([]Interval[K], 0, len(intervals))
+	for _, iv := range intervals {
+		if compare(iv.Low, iv.High) <= 0 {
+			sorted = append(sorted, iv)
+		}
+	}
+	slices.SortFunc(sorted, func(a, b Interval[K]) int {
+		return compare(a.Low, b.Low)
+	})
+
+	merged := make([]Interval[K], 0, len(sorted))
+	for _, iv := range sorted {
+		last := len(merged) - 1
+		if last >= 0 && compare(iv.Low, merged[last].High) <= 0 {
+			if compare(iv.High, merged[last].High) > 0 {
+				merged[last].High = iv.High
+			}
+			continue
+		}
+		merged = append(merged, iv)
+	}
+	return merged
+}
@@ -0,0 +1,229 @@
+package interval
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type IntervalTreeTestSuite struct {
+	suite.Suite
+	tree *IntervalTree[int, string]
+}
+
+func TestIntervalTreeTestSuite(t *testing.T) {
+	suite.Run(t, new(IntervalTreeTestSuite))
+}
+
+func (s *IntervalTreeTestSuite) SetupTest() {
+	s.tree = NewIntervalTree[int, string]()
+	for _, iv := range []Interval[int]{{15, 20}, {10, 30}, {17, 19}, {5, 20}, {12, 15}, {30, 40}} {
+		_, err := s.tree.Insert(iv, "")
+		s.Require().NoError(err)
+	}
+}
+
+func collect[K any, V any](seq func(func(Interval[K], V) bool)) []Interval[K] {
+	result := []Interval[K]{}
+	for iv := range seq {
+		result = append(result, iv)
+	}
+	return result
+}
+
+// sorted collects a query result in ascending order of Low, then High.
+func sorted[K any, V any](t *IntervalTree[K, V], seq func(func(Interval[K], V) bool)) []Interval[K] {
+	result := collect(seq)
+	slices.SortFunc(result, t.compareIntervals)
+	return result
+}
+
+// checkTree verifies the AVL shape, the ordering and the priority search tree:
+// every entry is held on the path to its own node or parked there, held
+// entries are in heap order on High, and an empty slot has nothing below it.
+func (s *IntervalTreeTestSuite) checkTree(t *IntervalTree[int, string]) {
+	seen := map[*entry[int, string]]int{}
+	var check func(n *node[int, string], path []*node[int, string]) int
+	check = func(n *node[int, string], path []*node[int, string]) int {
+		if n == nil {
+			return 0
+		}
+		path = append(path, n)
+		if n.left != nil {
+			s.Negative(t.compareIntervals(n.left.entry.interval, n.entry.interval))
+		}
+		if n.right != nil {
+			s.Positive(t.compareIntervals(n.right.entry.interval, n.entry.interval))
+		}
+		if n.held == nil {
+			s.False(n.parked)
+			for _, child := range []*node[int, string]{n.left, n.right} {
+				if child != nil {
+					s.Nil(child.held)
+				}
+			}
+		} else {
+			seen[n.held]++
+			for _, child := range []*node[int, string]{n.left, n.right} {
+				if child != nil && child.held != nil {
+					s.LessOrEqual(child.held.interval.High, n.held.interval.High)
+				}
+			}
+		}
+		if n.parked {
+			seen[n.entry]++
+			s.LessOrEqual(n.entry.interval.High, n.held.interval.High)
+		}
+		held := false
+		for _, ancestor := range path {
+			held = held || ancestor.held == n.entry
+		}
+		s.True(held || n.parked, "entry %v is lost", n.entry.interval)
+
+		lh, rh := check(n.left, path), check(n.right, path)
+		s.LessOrEqual(max(lh-rh, rh-lh), 1)
+		s.Equal(max(lh, rh)+1, n.height)
+		return n.height
+	}
+	check(t.root, nil)
+	s.Len(seen, t.size)
+	for e, count := range seen {
+		s.Equal(1, count, "entry %v", e.interval)
+	}
+}
+
+func (s *IntervalTreeTestSuite) TestInsertDelete() {
+	s.Equal(6, s.tree.Len())
+	added, err := s.tree.Insert(Interval[int]{10, 30}, "again")
+	s.NoError(err)
+	s.False(added)
+	value, ok := s.tree.Get(Interval[int]{10, 30})
+	s.True(ok)
+	s.Equal("again", value)
+	s.False(s.tree.Contains(Interval[int]{10, 31}))
+
+	_, err = s.tree.Insert(Interval[int]{5, 4}, "")
+	s.Error(err)
+	s.Equal(6, s.tree.Len())
+
+	s.True(s.tree.Delete(Interval[int]{10, 30}))
+	s.False(s.tree.Delete(Interval[int]{10, 30}))
+	s.Equal(5, s.tree.Len())
+	s.checkTree(s.tree)
+	s.Equal([]Interval[int]{{5, 20}, {12, 15}, {15, 20}, {17, 19}, {30, 40}}, collect(s.tree.All()))
+
+	s.tree.Clear()
+	s.True(s.tree.IsEmpty())
+	s.Empty(collect(s.tree.All()))
+}
+
+func (s *IntervalTreeTestSuite) TestOverlapping() {
+	s.Equal([]Interval[int]{{5, 20}, {10, 30}, {15, 20}, {17, 19}},
+		sorted(s.tree, s.tree.Overlapping(Interval[int]{16, 18})))
+	// Endpoints are inclusive.
+	s.Equal([]Interval[int]{{10, 30}, {30, 40}}, sorted(s.tree, s.tree.Overlapping(Interval[int]{30, 35})))
+	s.Equal([]Interval[int]{{30, 40}}, collect(s.tree.OverlappingPoint(40)))
+	s.Empty(collect(s.tree.OverlappingPoint(41)))
+	s.Empty(collect(s.tree.OverlappingPoint(4)))
+	s.Empty(collect(s.tree.Overlapping(Interval[int]{20, 10})))
+
+	seen := []Interval[int]{}
+	for iv := range s.tree.OverlappingPoint(15) {
+		seen = append(seen, iv)
+		if len(seen) == 2 {
+			break
+		}
+	}
+	s.Len(seen, 2)
+	s.Subset([]Interval[int]{{5, 20}, {10, 30}, {12, 15}, {15, 20}}, seen)
+}
+
+func (s *IntervalTreeTestSuite) TestQueryIsOutputSensitive() {
+	// Short intervals everywhere and a few long ones spread among them: a
+	// query past the short ones matches only the long ones, and must not pay
+	// a root-to-leaf path for each of them.
+	compares := 0
+	tree := NewIntervalTreeFunc[int, string](func(a, b int) int {
+		compares++
+		return cmp.Compare(a, b)
+	})
+	const n, long = 1 << 12, 64
+	for i := 0; i < n; i++ {
+		high := i
+		if i%(n/long) == 0 {
+			high = 2 * n
+		}
+		_, err := tree.Insert(Interval[int]{i, high}, "")
+		s.Require().NoError(err)
+	}
+	s.checkTree(tree)
+
+	compares = 0
+	s.Len(collect(tree.OverlappingPoint(n+1)), long)
+	// Each visited node costs a handful of comparisons; log2(n) is 12.
+	s.Less(compares, 8*(12+long))
+}
+
+func (s *IntervalTreeTestSuite) TestRandomOperations() {
+	tree := NewIntervalTree[int, string]()
+	reference := map[Interval[int]]bool{}
+	rng := rand.New(rand.NewPCG(11, 12))
+	for i := 0; i < 4000; i++ {
+		low := rng.IntN(500)
+		iv := Interval[int]{low, low + rng.IntN(40)}
+		if rng.IntN(3) == 0 {
+			s.Equal(reference[iv], tree.Delete(iv))
+			delete(reference, iv)
+		} else {
+			added, err := tree.Insert(iv, "")
+			s.NoError(err)
+			s.Equal(!reference[iv], added)
+			reference[iv] = true
+		}
+		if i%250 == 0 {
+			s.checkTree(tree)
+		}
+	}
+	s.checkTree(tree)
+	s.Equal(len(reference), tree.Len())
+
+	for i := 0; i < 200; i++ {
+		low := rng.IntN(560) - 20
+		query := Interval[int]{low, low + rng.IntN(30)}
+		want := []Interval[int]{}
+		for iv := range reference {
+			if iv.Low <= query.High && query.Low <= iv.High {
+				want = append(want, iv)
+			}
+		}
+		slices.SortFunc(want, tree.compareIntervals)
+		s.Equal(want, sorted(tree, tree.Overlapping(query)), "query %v", query)
+	}
+}
+
+func (s *IntervalTreeTestSuite) TestComparator() {
+	tree := NewIntervalTreeFunc[string, int](func(a, b string) int {
+		return len(a) - len(b)
+	})
+	_, err := tree.Insert(Interval[string]{"a", "aaa"}, 1)
+	s.NoError(err)
+	_, err = tree.Insert(Interval[string]{"aaaa", "aaaaaa"}, 2)
+	s.NoError(err)
+	_, err = tree.Insert(Interval[string]{"zz", "z"}, 3)
+	s.Error(err)
+	s.Equal([]Interval[string]{{"aaaa", "aaaaaa"}}, collect(tree.OverlappingPoint("12345")))
+}
+
+func (s *IntervalTreeTestSuite) TestMergeOverlaps() {
+	input := []Interval[int]{{8, 10}, {1, 3}, {2, 6}, {15, 18}, {10, 12}, {20, 19}, {17, 17}}
+	s.Equal([]Interval[int]{{1, 6}, {8, 12}, {15, 18}}, MergeOverlaps(input))
+	s.Equal(Interval[int]{8, 10}, input[0])
+	s.Empty(MergeOverlaps[int](nil))
+	s.Equal([]Interval[int]{{1, 10}}, MergeOverlaps([]Interval[int]{{1, 10}, {2, 3}, {4, 5}}))
+
+	reversed := MergeOverlapsFunc([]Interval[int]{{9, 5}, {6, 2}, {1, 0}}, func(a, b int) int { return b - a })
+	s.Equal([]Interval[int]{{9, 2}, {1, 0}}, reversed)
+}
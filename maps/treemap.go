@@ -0,0 +1,413 @@
+package maps
+
+import (
+	"cmp"
+	"iter"
+)
+
+type treeMapNode[K any, V any] struct {
+	key    K
+	value  V
+	left   *treeMapNode[K, V]
+	right  *treeMapNode[K, V]
+	height int
+	size   int
+}
+
+// TreeMap is a map that keeps its keys sorted, backed by an AVL tree so every
+// operation is O(log n). Unlike GenericHashMap it is not safe for concurrent
+// use, and the map must not be modified while one of its iterators is running.
+type TreeMap[K any, V any] struct {
+	root    *treeMapNode[K, V]
+	size    int
+	compare func(a, b K) int
+}
+
+func NewTreeMap[K cmp.Ordered, V any]() *TreeMap[K, V] {
+	return NewTreeMapFunc[K, V](cmp.Compare[K])
+}
+
+// NewTreeMapFunc orders keys with compare, which returns a negative number,
+// zero or a positive number as a is less than, equal to or greater than b.
+func NewTreeMapFunc[K any, V any](compare func(a, b K) int) *TreeMap[K, V] {
+	return &TreeMap[K, V]{compare: compare}
+}
+
+// getHeight and getSize treat a nil subtree as empty.
+func (n *treeMapNode[K, V]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *treeMapNode[K, V]) getSize() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *treeMapNode[K, V]) update() {
+	n.height = max(n.left.getHeight(), n.right.getHeight()) + 1
+	n.size = n.left.getSize() + n.right.getSize() + 1
+}
+
+func (n *treeMapNode[K, V]) rotateRight() *treeMapNode[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.update()
+	l.update()
+	return l
+}
+
+func (n *treeMapNode[K, V]) rotateLeft() *treeMapNode[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.update()
+	r.update()
+	return r
+}
+
+// balance restores the AVL invariant at n after one of its subtrees changed
+// height by at most one.
+func (n *treeMapNode[K, V]) balance() *treeMapNode[K, V] {
+	n.update()
+	switch diff := n.left.getHeight() - n.right.getHeight(); {
+	case diff > 1:
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case diff < -1:
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+// Put stores value under key and reports whether the key is new.
+func (tm *TreeMap[K, V]) Put(key K, value V) bool {
+	added := false
+	tm.root = tm.put(tm.root, key, value, &added)
+	if added {
+		tm.size++
+	}
+	return added
+}
+
+func (tm *TreeMap[K, V]) put(n *treeMapNode[K, V], key K, value V, added *bool) *treeMapNode[K, V] {
+	if n == nil {
+		*added = true
+		return &treeMapNode[K, V]{key: key, value: value, height: 1, size: 1}
+	}
+	switch c := tm.compare(key, n.key); {
+	case c < 0:
+		n.left = tm.put(n.left, key, value, added)
+	case c > 0:
+		n.right = tm.put(n.right, key, value, added)
+	default:
+		n.value = value
+		return n
+	}
+	return n.balance()
+}
+
+func (tm *TreeMap[K, V]) find(key K) *treeMapNode[K, V] {
+	n := tm.root
+	for n != nil {
+		switch c := tm.compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+func (tm *TreeMap[K, V]) Get(key K) (V, bool) {
+	if n := tm.find(key); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (tm *TreeMap[K, V]) ContainsKey(key K) bool {
+	return tm.find(key) != nil
+}
+
+// Remove deletes key and reports whether it was present.
+func (tm *TreeMap[K, V]) Remove(key K) bool {
+	removed := false
+	tm.root = tm.remove(tm.root, key, &removed)
+	if removed {
+		tm.size--
+	}
+	return removed
+}
+
+func (tm *TreeMap[K, V]) remove(n *treeMapNode[K, V], key K, removed *bool) *treeMapNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	switch c := tm.compare(key, n.key); {
+	case c < 0:
+		n.left = tm.remove(n.left, key, removed)
+	case c > 0:
+		n.right = tm.remove(n.right, key, removed)
+	default:
+		*removed = true
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		var successor *treeMapNode[K, V]
+		n.right = n.right.removeMin(&successor)
+		successor.left, successor.right = n.left, n.right
+		n = successor
+	}
+	return n.balance()
+}
+
+// removeMin detaches the smallest node of the subtree into *min.
+func (n *treeMapNode[K, V]) removeMin(min **treeMapNode[K, V]) *treeMapNode[K, V] {
+	if n.left == nil {
+		*min = n
+		return n.right
+	}
+	n.left = n.left.removeMin(min)
+	return n.balance()
+}
+
+func (tm *TreeMap[K, V]) Size() int {
+	return tm.size
+}
+
+func (tm *TreeMap[K, V]) IsEmpty() bool {
+	return tm.size == 0
+}
+
+func (tm *TreeMap[K, V]) Clear() {
+	tm.root = nil
+	tm.size = 0
+}
+
+func (tm *TreeMap[K, V]) first() *treeMapNode[K, V] {
+	n := tm.root
+	for n != nil && n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func (tm *TreeMap[K, V]) last() *treeMapNode[K, V] {
+	n := tm.root
+	for n != nil && n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+// entry unpacks a possibly nil node.
+func (n *treeMapNode[K, V]) entry() (K, V, bool) {
+	if n == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	return n.key, n.value, true
+}
+
+func (tm *TreeMap[K, V]) FirstKey() (K, bool) {
+	key, _, ok := tm.first().entry()
+	return key, ok
+}
+
+func (tm *TreeMap[K, V]) LastKey() (K, bool) {
+	key, _, ok := tm.last().entry()
+	return key, ok
+}
+
+// PollFirst removes and returns the entry with the smallest key.
+func (tm *TreeMap[K, V]) PollFirst() (K, V, bool) {
+	key, value, ok := tm.first().entry()
+	if ok {
+		tm.Remove(key)
+	}
+	return key, value, ok
+}
+
+// PollLast removes and returns the entry with the largest key.
+func (tm *TreeMap[K, V]) PollLast() (K, V, bool) {
+	key, value, ok := tm.last().entry()
+	if ok {
+		tm.Remove(key)
+	}
+	return key, value, ok
+}
+
+// search returns the closest node on the side of key selected by below and
+// inclusive: the greatest key <= key (Floor), < key (Lower), or the least key
+// >= key (Ceiling), > key (Higher).
+func (tm *TreeMap[K, V]) search(key K, below, inclusive bool) *treeMapNode[K, V] {
+	var best *treeMapNode[K, V]
+	for n := tm.root; n != nil; {
+		c := tm.compare(key, n.key)
+		if c == 0 && inclusive {
+			return n
+		}
+		if below {
+			if c > 0 {
+				best, n = n, n.right
+			} else {
+				n = n.left
+			}
+		} else {
+			if c < 0 {
+				best, n = n, n.left
+			} else {
+				n = n.right
+			}
+		}
+	}
+	return best
+}
+
+// Floor returns the entry with the greatest key less than or equal to key.
+func (tm *TreeMap[K, V]) Floor(key K) (K, V, bool) {
+	return tm.search(key, true, true).entry()
+}
+
+// Ceiling returns the entry with the least key greater than or equal to key.
+func (tm *TreeMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return tm.search(key, false, true).entry()
+}
+
+// Lower returns the entry with the greatest key strictly less than key.
+func (tm *TreeMap[K, V]) Lower(key K) (K, V, bool) {
+	return tm.search(key, true, false).entry()
+}
+
+// Higher returns the entry with the least key strictly greater than key.
+func (tm *TreeMap[K, V]) Higher(key K) (K, V, bool) {
+	return tm.search(key, false, false).entry()
+}
+
+// Select returns the entry with the k-th smallest key, counting from zero.
+func (tm *TreeMap[K, V]) Select(k int) (K, V, bool) {
+	if k < 0 || k >= tm.size {
+		return (*treeMapNode[K, V])(nil).entry()
+	}
+	n := tm.root
+	for {
+		left := n.left.getSize()
+		switch {
+		case k < left:
+			n = n.left
+		case k > left:
+			k -= left + 1
+			n = n.right
+		default:
+			return n.entry()
+		}
+	}
+}
+
+// Rank returns how many keys are less than key.
+func (tm *TreeMap[K, V]) Rank(key K) int {
+	rank := 0
+	for n := tm.root; n != nil; {
+		if tm.compare(key, n.key) > 0 {
+			rank += n.left.getSize() + 1
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return rank
+}
+
+// treeMapBound limits an iteration; a nil bound is open.
+type treeMapBound[K any] struct {
+	key       K
+	inclusive bool
+}
+
+// ascend yields the entries between lo and hi in key order, visiting only the
+// O(log n) nodes on the boundary paths plus the entries it yields.
+func (tm *TreeMap[K, V]) ascend(lo, hi *treeMapBound[K]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		tm.walk(tm.root, lo, hi, yield)
+	}
+}
+
+func (tm *TreeMap[K, V]) walk(n *treeMapNode[K, V], lo, hi *treeMapBound[K], yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	aboveLo, belowHi := true, true
+	if lo != nil {
+		c := tm.compare(n.key, lo.key)
+		aboveLo = c > 0 || (c == 0 && lo.inclusive)
+	}
+	if hi != nil {
+		c := tm.compare(n.key, hi.key)
+		belowHi = c < 0 || (c == 0 && hi.inclusive)
+	}
+	if aboveLo && !tm.walk(n.left, lo, hi, yield) {
+		return false
+	}
+	if aboveLo && belowHi && !yield(n.key, n.value) {
+		return false
+	}
+	if belowHi {
+		return tm.walk(n.right, lo, hi, yield)
+	}
+	return true
+}
+
+// All yields every entry in ascending key order.
+func (tm *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return tm.ascend(nil, nil)
+}
+
+func (tm *TreeMap[K, V]) Keys() []K {
+	keys := make([]K, 0, tm.size)
+	for k := range tm.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (tm *TreeMap[K, V]) Values() []V {
+	values := make([]V, 0, tm.size)
+	for _, v := range tm.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+// Range yields the entries with lo <= key <= hi in ascending key order.
+func (tm *TreeMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return tm.ascend(&treeMapBound[K]{lo, true}, &treeMapBound[K]{hi, true})
+}
+
+// HeadMap yields the entries with keys strictly less than hi.
+func (tm *TreeMap[K, V]) HeadMap(hi K) iter.Seq2[K, V] {
+	return tm.ascend(nil, &treeMapBound[K]{hi, false})
+}
+
+// TailMap yields the entries with keys greater than or equal to lo.
+func (tm *TreeMap[K, V]) TailMap(lo K) iter.Seq2[K, V] {
+	return tm.ascend(&treeMapBound[K]{lo, true}, nil)
+}
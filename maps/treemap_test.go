@@ -0,0 +1,216 @@
+package maps
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TreeMapTestSuite struct {
+	suite.Suite
+	treeMap *TreeMap[int, string]
+}
+
+func TestTreeMapTestSuite(t *testing.T) {
+	suite.Run(t, new(TreeMapTestSuite))
+}
+
+func (s *TreeMapTestSuite) SetupTest() {
+	s.treeMap = NewTreeMap[int, string]()
+	for _, k := range []int{50, 20, 80, 10, 30, 70, 90} {
+		s.treeMap.Put(k, strings.Repeat("x", k/10))
+	}
+}
+
+// checkAVL verifies ordering, cached heights and balance, returning the height.
+func (s *TreeMapTestSuite) checkAVL(n *treeMapNode[int, string], lo, hi *int) int {
+	if n == nil {
+		return 0
+	}
+	if lo != nil {
+		s.Greater(n.key, *lo)
+	}
+	if hi != nil {
+		s.Less(n.key, *hi)
+	}
+	l := s.checkAVL(n.left, lo, &n.key)
+	r := s.checkAVL(n.right, &n.key, hi)
+	s.LessOrEqual(l-r, 1)
+	s.GreaterOrEqual(l-r, -1)
+	s.Equal(max(l, r)+1, n.height)
+	s.Equal(n.left.getSize()+n.right.getSize()+1, n.size)
+	return n.height
+}
+
+func collectKeys[K any, V any](seq func(func(K, V) bool)) []K {
+	keys := []K{}
+	for k := range seq {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (s *TreeMapTestSuite) TestPutGetRemove() {
+	s.Equal(7, s.treeMap.Size())
+	s.False(s.treeMap.Put(20, "twenty"))
+	s.True(s.treeMap.Put(25, "y"))
+	s.Equal(8, s.treeMap.Size())
+
+	value, ok := s.treeMap.Get(20)
+	s.True(ok)
+	s.Equal("twenty", value)
+	_, ok = s.treeMap.Get(21)
+	s.False(ok)
+	s.True(s.treeMap.ContainsKey(25))
+
+	s.True(s.treeMap.Remove(50))
+	s.False(s.treeMap.Remove(50))
+	s.False(s.treeMap.ContainsKey(50))
+	s.Equal([]int{10, 20, 25, 30, 70, 80, 90}, s.treeMap.Keys())
+	s.Len(s.treeMap.Values(), 7)
+	s.checkAVL(s.treeMap.root, nil, nil)
+
+	s.treeMap.Clear()
+	s.True(s.treeMap.IsEmpty())
+	_, ok = s.treeMap.FirstKey()
+	s.False(ok)
+}
+
+func (s *TreeMapTestSuite) TestNavigation() {
+	key, _, ok := s.treeMap.Floor(55)
+	s.True(ok)
+	s.Equal(50, key)
+	key, _, _ = s.treeMap.Floor(50)
+	s.Equal(50, key)
+	_, _, ok = s.treeMap.Floor(5)
+	s.False(ok)
+
+	key, value, ok := s.treeMap.Ceiling(55)
+	s.True(ok)
+	s.Equal(70, key)
+	s.Equal("xxxxxxx", value)
+	key, _, _ = s.treeMap.Ceiling(70)
+	s.Equal(70, key)
+	_, _, ok = s.treeMap.Ceiling(95)
+	s.False(ok)
+
+	key, _, _ = s.treeMap.Lower(50)
+	s.Equal(30, key)
+	_, _, ok = s.treeMap.Lower(10)
+	s.False(ok)
+	key, _, _ = s.treeMap.Higher(50)
+	s.Equal(70, key)
+	_, _, ok = s.treeMap.Higher(90)
+	s.False(ok)
+
+	first, _ := s.treeMap.FirstKey()
+	last, _ := s.treeMap.LastKey()
+	s.Equal(10, first)
+	s.Equal(90, last)
+}
+
+func (s *TreeMapTestSuite) TestPoll() {
+	key, value, ok := s.treeMap.PollFirst()
+	s.True(ok)
+	s.Equal(10, key)
+	s.Equal("x", value)
+	key, _, _ = s.treeMap.PollLast()
+	s.Equal(90, key)
+	s.Equal(5, s.treeMap.Size())
+	s.Equal([]int{20, 30, 50, 70, 80}, s.treeMap.Keys())
+
+	empty := NewTreeMap[string, int]()
+	_, _, ok = empty.PollFirst()
+	s.False(ok)
+	_, _, ok = empty.PollLast()
+	s.False(ok)
+}
+
+func (s *TreeMapTestSuite) TestViews() {
+	s.Equal([]int{20, 30, 50}, collectKeys(s.treeMap.Range(20, 50)))
+	s.Equal([]int{30, 50, 70}, collectKeys(s.treeMap.Range(25, 75)))
+	s.Empty(collectKeys(s.treeMap.Range(51, 69)))
+	s.Empty(collectKeys(s.treeMap.Range(60, 40)))
+	s.Equal([]int{10, 20, 30}, collectKeys(s.treeMap.HeadMap(50)))
+	s.Equal([]int{50, 70, 80, 90}, collectKeys(s.treeMap.TailMap(50)))
+	s.Equal([]int{10, 20, 30, 50, 70, 80, 90}, collectKeys(s.treeMap.All()))
+
+	// Iteration stops as soon as the consumer breaks.
+	seen := []int{}
+	for k, v := range s.treeMap.TailMap(20) {
+		seen = append(seen, k)
+		s.NotEmpty(v)
+		if k == 50 {
+			break
+		}
+	}
+	s.Equal([]int{20, 30, 50}, seen)
+}
+
+func (s *TreeMapTestSuite) TestComparator() {
+	byLength := NewTreeMapFunc[string, int](func(a, b string) int { return len(b) - len(a) })
+	byLength.Put("go", 1)
+	byLength.Put("rust", 2)
+	byLength.Put("c", 3)
+	byLength.Put("ab", 4)
+
+	s.Equal([]string{"rust", "go", "c"}, byLength.Keys())
+	value, _ := byLength.Get("xy")
+	s.Equal(4, value)
+	key, _, _ := byLength.Floor("abc")
+	s.Equal("rust", key)
+}
+
+func (s *TreeMapTestSuite) TestStaysBalanced() {
+	tm := NewTreeMap[int, string]()
+	rng := rand.New(rand.NewPCG(7, 11))
+	reference := map[int]bool{}
+	for i := 0; i < 2000; i++ {
+		k := rng.IntN(500)
+		if rng.IntN(3) == 0 {
+			s.Equal(reference[k], tm.Remove(k))
+			delete(reference, k)
+		} else {
+			s.Equal(!reference[k], tm.Put(k, ""))
+			reference[k] = true
+		}
+	}
+	want := []int{}
+	for k := range reference {
+		want = append(want, k)
+	}
+	slices.Sort(want)
+	s.Equal(want, tm.Keys())
+	s.Equal(len(want), tm.Size())
+	s.LessOrEqual(s.checkAVL(tm.root, nil, nil), 12)
+
+	sorted := NewTreeMap[int, string]()
+	for i := 0; i < 1024; i++ {
+		sorted.Put(i, "")
+	}
+	s.LessOrEqual(s.checkAVL(sorted.root, nil, nil), 11)
+}
+
+func (s *TreeMapTestSuite) TestOrderStatistics() {
+	for k, want := range []int{10, 20, 30, 50, 70, 80, 90} {
+		key, _, ok := s.treeMap.Select(k)
+		s.True(ok)
+		s.Equal(want, key)
+		s.Equal(k, s.treeMap.Rank(want))
+	}
+	_, _, ok := s.treeMap.Select(7)
+	s.False(ok)
+	_, _, ok = s.treeMap.Select(-1)
+	s.False(ok)
+	s.Equal(3, s.treeMap.Rank(45))
+	s.Equal(7, s.treeMap.Rank(100))
+
+	s.treeMap.Remove(20)
+	key, value, _ := s.treeMap.Select(1)
+	s.Equal(30, key)
+	s.Equal("xxx", value)
+	s.checkAVL(s.treeMap.root, nil, nil)
+}
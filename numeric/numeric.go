@@ -0,0 +1,10 @@
+package numeric
+
+// Number is satisfied by the integer and floating-point types. It is the
+// element constraint of the structures that add values, such as
+// segmenttree.Sum and fenwick.FenwickTree.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
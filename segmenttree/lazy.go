@@ -0,0 +1,208 @@
+package segmenttree
+
+import (
+	"fmt"
+
+	"github.com/raj1kshtz/go-structurarium/numeric"
+	"github.com/raj1kshtz/go-structurarium/vector"
+)
+
+// Action describes updates of type U applied to whole ranges. Apply returns
+// the aggregate of length elements after update is applied to each of them,
+// given their aggregate before. Compose returns the single update equivalent
+// to applying older and then newer.
+type Action[T any, U any] struct {
+	Apply   func(update U, aggregate T, length int) T
+	Compose func(newer, older U) U
+}
+
+// AddToSum adds a value to every element of a range, for use with Sum.
+func AddToSum[T numeric.Number]() Action[T, T] {
+	return Action[T, T]{
+		Apply:   func(update, aggregate T, length int) T { return aggregate + update*T(length) },
+		Compose: func(newer, older T) T { return newer + older },
+	}
+}
+
+// AddToMinMax adds a value to every element of a range, for use with Min or
+// Max.
+func AddToMinMax[T numeric.Number]() Action[T, T] {
+	return Action[T, T]{
+		Apply:   func(update, aggregate T, length int) T { return aggregate + update },
+		Compose: func(newer, older T) T { return newer + older },
+	}
+}
+
+// LazySegmentTree is a SegmentTree that also applies an update to a whole
+// range in O(log n), deferring the work below each node until a later query
+// or update needs it. It is not safe for concurrent use.
+type LazySegmentTree[T any, U any] struct {
+	n       int
+	tree    []T
+	lazy    []U
+	pending []bool
+	monoid  Monoid[T]
+	action  Action[T, U]
+}
+
+// NewLazySegmentTree builds a tree over a copy of values in O(n).
+func NewLazySegmentTree[T any, U any](values []T, monoid Monoid[T], action Action[T, U]) *LazySegmentTree[T, U] {
+	n := len(values)
+	st := &LazySegmentTree[T, U]{
+		n:       n,
+		tree:    make([]T, 4*max(n, 1)),
+		lazy:    make([]U, 4*max(n, 1)),
+		pending: make([]bool, 4*max(n, 1)),
+		monoid:  monoid,
+		action:  action,
+	}
+	if n > 0 {
+		st.build(1, 0, n, values)
+	}
+	return st
+}
+
+// NewLazySegmentTreeFromVector builds a tree over a snapshot of v; later
+// changes to v are not reflected.
+func NewLazySegmentTreeFromVector[T any, U any](v *vector.WrapperVector[T], monoid Monoid[T], action Action[T, U]) *LazySegmentTree[T, U] {
+	return NewLazySegmentTree(v.ToArray(), monoid, action)
+}
+
+func (st *LazySegmentTree[T, U]) build(node, l, r int, values []T) {
+	if r-l == 1 {
+		st.tree[node] = values[l]
+		return
+	}
+	mid := (l + r) / 2
+	st.build(2*node, l, mid, values)
+	st.build(2*node+1, mid, r, values)
+	st.tree[node] = st.monoid.Combine(st.tree[2*node], st.tree[2*node+1])
+}
+
+// apply updates the aggregate of node, which covers [l, r), and records the
+// update for its children.
+func (st *LazySegmentTree[T, U]) apply(node, l, r int, update U) {
+	st.tree[node] = st.action.Apply(update, st.tree[node], r-l)
+	if r-l == 1 {
+		return
+	}
+	if st.pending[node] {
+		st.lazy[node] = st.action.Compose(update, st.lazy[node])
+	} else {
+		st.lazy[node] = update
+		st.pending[node] = true
+	}
+}
+
+// push hands the pending update of node down to its children.
+func (st *LazySegmentTree[T, U]) push(node, l, mid, r int) {
+	if !st.pending[node] {
+		return
+	}
+	st.apply(2*node, l, mid, st.lazy[node])
+	st.apply(2*node+1, mid, r, st.lazy[node])
+	var zero U
+	st.lazy[node] = zero
+	st.pending[node] = false
+}
+
+func (st *LazySegmentTree[T, U]) Len() int {
+	return st.n
+}
+
+func (st *LazySegmentTree[T, U]) Get(index int) (T, error) {
+	if index < 0 || index >= st.n {
+		var zero T
+		return zero, fmt.Errorf("index %d out of range [0, %d)", index, st.n)
+	}
+	return st.query(1, 0, st.n, index, index+1), nil
+}
+
+// Set replaces the element at index.
+func (st *LazySegmentTree[T, U]) Set(index int, value T) error {
+	if index < 0 || index >= st.n {
+		return fmt.Errorf("index %d out of range [0, %d)", index, st.n)
+	}
+	st.set(1, 0, st.n, index, value)
+	return nil
+}
+
+func (st *LazySegmentTree[T, U]) set(node, l, r, index int, value T) {
+	if r-l == 1 {
+		st.tree[node] = value
+		return
+	}
+	mid := (l + r) / 2
+	st.push(node, l, mid, r)
+	if index < mid {
+		st.set(2*node, l, mid, index, value)
+	} else {
+		st.set(2*node+1, mid, r, index, value)
+	}
+	st.tree[node] = st.monoid.Combine(st.tree[2*node], st.tree[2*node+1])
+}
+
+// Update applies update to every element in [lo, hi).
+func (st *LazySegmentTree[T, U]) Update(lo, hi int, update U) error {
+	if lo < 0 || hi > st.n || lo > hi {
+		return fmt.Errorf("range [%d, %d) out of range [0, %d]", lo, hi, st.n)
+	}
+	if lo < hi {
+		st.update(1, 0, st.n, lo, hi, update)
+	}
+	return nil
+}
+
+func (st *LazySegmentTree[T, U]) update(node, l, r, lo, hi int, update U) {
+	if lo <= l && r <= hi {
+		st.apply(node, l, r, update)
+		return
+	}
+	mid := (l + r) / 2
+	st.push(node, l, mid, r)
+	if lo < mid {
+		st.update(2*node, l, mid, lo, hi, update)
+	}
+	if hi > mid {
+		st.update(2*node+1, mid, r, lo, hi, update)
+	}
+	st.tree[node] = st.monoid.Combine(st.tree[2*node], st.tree[2*node+1])
+}
+
+// Query combines the elements in [lo, hi) from left to right; an empty range
+// yields the identity.
+func (st *LazySegmentTree[T, U]) Query(lo, hi int) (T, error) {
+	if lo < 0 || hi > st.n || lo > hi {
+		var zero T
+		return zero, fmt.Errorf("range [%d, %d) out of range [0, %d]", lo, hi, st.n)
+	}
+	if lo == hi {
+		return st.monoid.Identity, nil
+	}
+	return st.query(1, 0, st.n, lo, hi), nil
+}
+
+func (st *LazySegmentTree[T, U]) query(node, l, r, lo, hi int) T {
+	if lo <= l && r <= hi {
+		return st.tree[node]
+	}
+	mid := (l + r) / 2
+	st.push(node, l, mid, r)
+	result := st.monoid.Identity
+	if lo < mid {
+		result = st.query(2*node, l, mid, lo, hi)
+	}
+	if hi > mid {
+		result = st.monoid.Combine(result, st.query(2*node+1, mid, r, lo, hi))
+	}
+	return result
+}
+
+// Values returns the elements with every pending update applied.
+func (st *LazySegmentTree[T, U]) Values() []T {
+	values := make([]T, st.n)
+	for i := range values {
+		values[i] = st.query(1, 0, st.n, i, i+1)
+	}
+	return values
+}
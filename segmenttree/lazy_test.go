@@ -0,0 +1,127 @@
+package segmenttree
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/raj1kshtz/go-structurarium/vector"
+	"github.com/stretchr/testify/suite"
+)
+
+type LazySegmentTreeTestSuite struct {
+	suite.Suite
+	tree *LazySegmentTree[int, int]
+}
+
+func TestLazySegmentTreeTestSuite(t *testing.T) {
+	suite.Run(t, new(LazySegmentTreeTestSuite))
+}
+
+func (s *LazySegmentTreeTestSuite) SetupTest() {
+	s.tree = NewLazySegmentTree([]int{1, 2, 3, 4, 5}, Sum[int](), AddToSum[int]())
+}
+
+func (s *LazySegmentTreeTestSuite) TestRangeUpdate() {
+	s.NoError(s.tree.Update(1, 4, 10))
+	sum, err := s.tree.Query(0, 5)
+	s.NoError(err)
+	s.Equal(45, sum)
+	sum, _ = s.tree.Query(3, 5)
+	s.Equal(19, sum)
+	s.Equal([]int{1, 12, 13, 14, 5}, s.tree.Values())
+
+	s.NoError(s.tree.Update(0, 5, -1))
+	s.NoError(s.tree.Set(2, 0))
+	v, err := s.tree.Get(3)
+	s.NoError(err)
+	s.Equal(13, v)
+	s.Equal([]int{0, 11, 0, 13, 4}, s.tree.Values())
+
+	s.NoError(s.tree.Update(2, 2, 100))
+	sum, _ = s.tree.Query(0, 5)
+	s.Equal(28, sum)
+}
+
+func (s *LazySegmentTreeTestSuite) TestBounds() {
+	s.Error(s.tree.Update(-1, 2, 1))
+	s.Error(s.tree.Update(3, 2, 1))
+	_, err := s.tree.Query(0, 6)
+	s.Error(err)
+	_, err = s.tree.Get(5)
+	s.Error(err)
+	s.Error(s.tree.Set(-1, 0))
+
+	empty := NewLazySegmentTree([]int{}, Sum[int](), AddToSum[int]())
+	s.NoError(empty.Update(0, 0, 1))
+	sum, err := empty.Query(0, 0)
+	s.NoError(err)
+	s.Equal(0, sum)
+	s.Empty(empty.Values())
+}
+
+func (s *LazySegmentTreeTestSuite) TestAssignAndMin() {
+	// Assigning a constant composes by keeping the newer value.
+	assign := Action[int, int]{
+		Apply:   func(update, _ int, _ int) int { return update },
+		Compose: func(newer, _ int) int { return newer },
+	}
+	tree := NewLazySegmentTree([]int{7, 4, 9, 6}, Min(math.MaxInt), assign)
+	s.NoError(tree.Update(0, 2, 8))
+	v, _ := tree.Query(0, 4)
+	s.Equal(6, v)
+	s.NoError(tree.Update(1, 4, 2))
+	s.Equal([]int{8, 2, 2, 2}, tree.Values())
+}
+
+func (s *LazySegmentTreeTestSuite) TestFromVector() {
+	v := vector.NewWrapperVector[int]()
+	for i := 1; i <= 4; i++ {
+		v.Add(i)
+	}
+	tree := NewLazySegmentTreeFromVector(v, Max(math.MinInt), AddToMinMax[int]())
+	s.NoError(tree.Update(0, 2, 5))
+	high, _ := tree.Query(0, 4)
+	s.Equal(7, high)
+	high, _ = tree.Query(2, 4)
+	s.Equal(4, high)
+}
+
+func (s *LazySegmentTreeTestSuite) TestRandomOperations() {
+	rng := rand.New(rand.NewPCG(3, 4))
+	reference := make([]int, 29)
+	sums := NewLazySegmentTree(reference, Sum[int](), AddToSum[int]())
+	mins := NewLazySegmentTree(reference, Min(math.MaxInt), AddToMinMax[int]())
+	for i := 0; i < 3000; i++ {
+		lo := rng.IntN(len(reference) + 1)
+		hi := lo + rng.IntN(len(reference)-lo+1)
+		switch rng.IntN(3) {
+		case 0:
+			delta := rng.IntN(21) - 10
+			s.NoError(sums.Update(lo, hi, delta))
+			s.NoError(mins.Update(lo, hi, delta))
+			for j := lo; j < hi; j++ {
+				reference[j] += delta
+			}
+		case 1:
+			if lo < len(reference) {
+				value := rng.IntN(100)
+				s.NoError(sums.Set(lo, value))
+				s.NoError(mins.Set(lo, value))
+				reference[lo] = value
+			}
+		default:
+			wantSum, wantMin := 0, math.MaxInt
+			for _, v := range reference[lo:hi] {
+				wantSum += v
+				wantMin = min(wantMin, v)
+			}
+			got, _ := sums.Query(lo, hi)
+			s.Equal(wantSum, got)
+			got, _ = mins.Query(lo, hi)
+			s.Equal(wantMin, got)
+		}
+	}
+	s.Equal(reference, sums.Values())
+	s.Equal(reference, mins.Values())
+}
@@ -0,0 +1,112 @@
+package segmenttree
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/raj1kshtz/go-structurarium/numeric"
+	"github.com/raj1kshtz/go-structurarium/vector"
+)
+
+// Monoid is the aggregate a segment tree maintains. Combine must be
+// associative and Identity must leave any value unchanged when combined with
+// it; Combine need not be commutative.
+type Monoid[T any] struct {
+	Combine  func(a, b T) T
+	Identity T
+}
+
+// Sum aggregates by addition.
+func Sum[T numeric.Number]() Monoid[T] {
+	return Monoid[T]{Combine: func(a, b T) T { return a + b }}
+}
+
+// Min aggregates to the smallest value. identity must be no smaller than any
+// value stored, such as math.MaxInt or math.Inf(1).
+func Min[T cmp.Ordered](identity T) Monoid[T] {
+	return Monoid[T]{Combine: func(a, b T) T { return min(a, b) }, Identity: identity}
+}
+
+// Max aggregates to the largest value. identity must be no larger than any
+// value stored, such as math.MinInt or math.Inf(-1).
+func Max[T cmp.Ordered](identity T) Monoid[T] {
+	return Monoid[T]{Combine: func(a, b T) T { return max(a, b) }, Identity: identity}
+}
+
+// SegmentTree answers range queries under a monoid over a fixed-length
+// sequence, with point updates. Both take O(log n). It is not safe for
+// concurrent use; see LazySegmentTree for range updates.
+type SegmentTree[T any] struct {
+	n      int
+	tree   []T
+	monoid Monoid[T]
+}
+
+// NewSegmentTree builds a tree over a copy of values in O(n).
+func NewSegmentTree[T any](values []T, monoid Monoid[T]) *SegmentTree[T] {
+	n := len(values)
+	tree := make([]T, 2*n)
+	copy(tree[n:], values)
+	for i := n - 1; i > 0; i-- {
+		tree[i] = monoid.Combine(tree[2*i], tree[2*i+1])
+	}
+	return &SegmentTree[T]{n: n, tree: tree, monoid: monoid}
+}
+
+// NewSegmentTreeFromVector builds a tree over a snapshot of v; later changes
+// to v are not reflected.
+func NewSegmentTreeFromVector[T any](v *vector.WrapperVector[T], monoid Monoid[T]) *SegmentTree[T] {
+	return NewSegmentTree(v.ToArray(), monoid)
+}
+
+func (st *SegmentTree[T]) Len() int {
+	return st.n
+}
+
+func (st *SegmentTree[T]) Get(index int) (T, error) {
+	if index < 0 || index >= st.n {
+		var zero T
+		return zero, fmt.Errorf("index %d out of range [0, %d)", index, st.n)
+	}
+	return st.tree[st.n+index], nil
+}
+
+// Set replaces the element at index.
+func (st *SegmentTree[T]) Set(index int, value T) error {
+	if index < 0 || index >= st.n {
+		return fmt.Errorf("index %d out of range [0, %d)", index, st.n)
+	}
+	i := st.n + index
+	st.tree[i] = value
+	for i > 1 {
+		i /= 2
+		st.tree[i] = st.monoid.Combine(st.tree[2*i], st.tree[2*i+1])
+	}
+	return nil
+}
+
+// Query combines the elements in [lo, hi) from left to right; an empty range
+// yields the identity.
+func (st *SegmentTree[T]) Query(lo, hi int) (T, error) {
+	if lo < 0 || hi > st.n || lo > hi {
+		var zero T
+		return zero, fmt.Errorf("range [%d, %d) out of range [0, %d]", lo, hi, st.n)
+	}
+	left, right := st.monoid.Identity, st.monoid.Identity
+	for l, r := lo+st.n, hi+st.n; l < r; l, r = l/2, r/2 {
+		if l%2 == 1 {
+			left = st.monoid.Combine(left, st.tree[l])
+			l++
+		}
+		if r%2 == 1 {
+			r--
+			right = st.monoid.Combine(st.tree[r], right)
+		}
+	}
+	return st.monoid.Combine(left, right), nil
+}
+
+// Values returns a copy of the elements.
+func (st *SegmentTree[T]) Values() []T {
+	return append([]T{}, st.tree[st.n:]...)
+}
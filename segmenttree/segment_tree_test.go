@@ -0,0 +1,122 @@
+package segmenttree
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/raj1kshtz/go-structurarium/vector"
+	"github.com/stretchr/testify/suite"
+)
+
+type SegmentTreeTestSuite struct {
+	suite.Suite
+	tree *SegmentTree[int]
+}
+
+func TestSegmentTreeTestSuite(t *testing.T) {
+	suite.Run(t, new(SegmentTreeTestSuite))
+}
+
+func (s *SegmentTreeTestSuite) SetupTest() {
+	s.tree = NewSegmentTree([]int{5, 3, 8, 1, 9, 2}, Sum[int]())
+}
+
+func (s *SegmentTreeTestSuite) TestQueryAndSet() {
+	sum, err := s.tree.Query(0, 6)
+	s.NoError(err)
+	s.Equal(28, sum)
+	sum, _ = s.tree.Query(1, 4)
+	s.Equal(12, sum)
+	sum, _ = s.tree.Query(3, 3)
+	s.Equal(0, sum)
+
+	s.NoError(s.tree.Set(2, 10))
+	sum, _ = s.tree.Query(1, 4)
+	s.Equal(14, sum)
+	v, err := s.tree.Get(2)
+	s.NoError(err)
+	s.Equal(10, v)
+	s.Equal([]int{5, 3, 10, 1, 9, 2}, s.tree.Values())
+	s.Equal(6, s.tree.Len())
+}
+
+func (s *SegmentTreeTestSuite) TestBounds() {
+	_, err := s.tree.Query(-1, 2)
+	s.Error(err)
+	_, err = s.tree.Query(2, 7)
+	s.Error(err)
+	_, err = s.tree.Query(4, 3)
+	s.Error(err)
+	s.Error(s.tree.Set(6, 0))
+	_, err = s.tree.Get(-1)
+	s.Error(err)
+
+	empty := NewSegmentTree([]int{}, Sum[int]())
+	sum, err := empty.Query(0, 0)
+	s.NoError(err)
+	s.Equal(0, sum)
+}
+
+func (s *SegmentTreeTestSuite) TestMinMax() {
+	values := []int{5, 3, 8, 1, 9, 2}
+	low := NewSegmentTree(values, Min(math.MaxInt))
+	high := NewSegmentTree(values, Max(math.MinInt))
+	v, _ := low.Query(0, 3)
+	s.Equal(3, v)
+	v, _ = high.Query(2, 6)
+	s.Equal(9, v)
+	v, _ = low.Query(2, 2)
+	s.Equal(math.MaxInt, v)
+}
+
+func (s *SegmentTreeTestSuite) TestNonCommutative() {
+	concat := Monoid[string]{Combine: func(a, b string) string { return a + b }}
+	letters := []string{"a", "b", "c", "d", "e", "f", "g"}
+	tree := NewSegmentTree(letters, concat)
+	for lo := 0; lo <= len(letters); lo++ {
+		for hi := lo; hi <= len(letters); hi++ {
+			want := ""
+			for _, l := range letters[lo:hi] {
+				want += l
+			}
+			got, err := tree.Query(lo, hi)
+			s.NoError(err)
+			s.Equal(want, got)
+		}
+	}
+}
+
+func (s *SegmentTreeTestSuite) TestFromVector() {
+	v := vector.NewWrapperVector[float64]()
+	for _, x := range []float64{1.5, 2.5, 3} {
+		v.Add(x)
+	}
+	tree := NewSegmentTreeFromVector(v, Sum[float64]())
+	v.Set(0, 100)
+	sum, _ := tree.Query(0, 3)
+	s.Equal(7.0, sum)
+}
+
+func (s *SegmentTreeTestSuite) TestRandomOperations() {
+	rng := rand.New(rand.NewPCG(1, 2))
+	reference := make([]int, 37)
+	tree := NewSegmentTree(reference, Min(math.MaxInt))
+	for i := 0; i < 2000; i++ {
+		if rng.IntN(2) == 0 {
+			index, value := rng.IntN(len(reference)), rng.IntN(1000)
+			s.NoError(tree.Set(index, value))
+			reference[index] = value
+			continue
+		}
+		lo := rng.IntN(len(reference) + 1)
+		hi := lo + rng.IntN(len(reference)-lo+1)
+		want := math.MaxInt
+		for _, v := range reference[lo:hi] {
+			want = min(want, v)
+		}
+		got, err := tree.Query(lo, hi)
+		s.NoError(err)
+		s.Equal(want, got)
+	}
+}
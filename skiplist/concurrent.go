@@ -0,0 +1,243 @@
+package skiplist
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+type concurrentNode[K any, V any] struct {
+	key         K
+	value       atomic.Pointer[V]
+	next        []atomic.Pointer[concurrentNode[K, V]]
+	mu          sync.Mutex
+	marked      atomic.Bool
+	fullyLinked atomic.Bool
+}
+
+// ConcurrentSkipList is an ordered map safe for use by many goroutines. It
+// implements the lazy skip list of Herlihy, Lev, Luchangco and Shavit: Search
+// and iteration take no locks, while Insert and Delete lock only the few
+// predecessor nodes they relink. Iteration is weakly consistent: it never
+// yields a key twice or out of order, and reflects some but not necessarily
+// all changes made while it runs. Rank queries are not supported, since
+// per-link widths cannot be kept exact without a global lock.
+type ConcurrentSkipList[K any, V any] struct {
+	head    *concurrentNode[K, V]
+	length  atomic.Int64
+	compare func(a, b K) int
+	rngMu   sync.Mutex
+	rng     *rand.Rand
+}
+
+func NewConcurrentSkipList[K cmp.Ordered, V any]() *ConcurrentSkipList[K, V] {
+	return NewConcurrentSkipListFuncWithSeed[K, V](cmp.Compare[K], rand.Uint64())
+}
+
+// NewConcurrentSkipListWithSeed fixes the sequence of tower heights; the list
+// is only reproducible when operations run in a fixed order.
+func NewConcurrentSkipListWithSeed[K cmp.Ordered, V any](seed uint64) *ConcurrentSkipList[K, V] {
+	return NewConcurrentSkipListFuncWithSeed[K, V](cmp.Compare[K], seed)
+}
+
+func NewConcurrentSkipListFunc[K any, V any](compare func(a, b K) int) *ConcurrentSkipList[K, V] {
+	return NewConcurrentSkipListFuncWithSeed[K, V](compare, rand.Uint64())
+}
+
+func NewConcurrentSkipListFuncWithSeed[K any, V any](compare func(a, b K) int, seed uint64) *ConcurrentSkipList[K, V] {
+	head := &concurrentNode[K, V]{next: make([]atomic.Pointer[concurrentNode[K, V]], maxLevel)}
+	head.fullyLinked.Store(true)
+	return &ConcurrentSkipList[K, V]{
+		head:    head,
+		compare: compare,
+		rng:     rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+func (sl *ConcurrentSkipList[K, V]) randomLevel() int {
+	sl.rngMu.Lock()
+	defer sl.rngMu.Unlock()
+	return randomLevel(sl.rng)
+}
+
+// find fills preds and succs with the neighbours of key on every level and
+// returns the highest level on which a node with key was seen, or -1. A nil
+// successor stands for the end of the list.
+func (sl *ConcurrentSkipList[K, V]) find(key K, preds, succs *[maxLevel]*concurrentNode[K, V]) int {
+	found := -1
+	pred := sl.head
+	for level := maxLevel - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && sl.compare(curr.key, key) < 0 {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+		if found == -1 && curr != nil && sl.compare(curr.key, key) == 0 {
+			found = level
+		}
+		preds[level] = pred
+		succs[level] = curr
+	}
+	return found
+}
+
+// lockPreds locks the distinct predecessors on levels [0, top) in that order,
+// which is descending key order, and stops early once valid reports false. It
+// returns the function that releases every lock taken.
+func lockPreds[K any, V any](preds *[maxLevel]*concurrentNode[K, V], top int, valid func(level int) bool) (bool, func()) {
+	locked := []*concurrentNode[K, V]{}
+	unlock := func() {
+		for _, n := range locked {
+			n.mu.Unlock()
+		}
+	}
+	for level := 0; level < top; level++ {
+		pred := preds[level]
+		if len(locked) == 0 || locked[len(locked)-1] != pred {
+			pred.mu.Lock()
+			locked = append(locked, pred)
+		}
+		if !valid(level) {
+			return false, unlock
+		}
+	}
+	return true, unlock
+}
+
+// Insert stores value under key and reports whether the key is new.
+func (sl *ConcurrentSkipList[K, V]) Insert(key K, value V) bool {
+	top := sl.randomLevel()
+	var preds, succs [maxLevel]*concurrentNode[K, V]
+	for {
+		if found := sl.find(key, &preds, &succs); found != -1 {
+			existing := succs[found]
+			if !existing.marked.Load() {
+				for !existing.fullyLinked.Load() {
+					runtime.Gosched()
+				}
+				existing.value.Store(&value)
+				return false
+			}
+			// The existing node is being deleted; retry once it is unlinked.
+			continue
+		}
+		ok, unlock := lockPreds(&preds, top, func(level int) bool {
+			pred, succ := preds[level], succs[level]
+			return !pred.marked.Load() && (succ == nil || !succ.marked.Load()) && pred.next[level].Load() == succ
+		})
+		if !ok {
+			unlock()
+			continue
+		}
+		n := &concurrentNode[K, V]{key: key, next: make([]atomic.Pointer[concurrentNode[K, V]], top)}
+		n.value.Store(&value)
+		for level := 0; level < top; level++ {
+			n.next[level].Store(succs[level])
+		}
+		for level := 0; level < top; level++ {
+			preds[level].next[level].Store(n)
+		}
+		n.fullyLinked.Store(true)
+		unlock()
+		sl.length.Add(1)
+		return true
+	}
+}
+
+// Delete removes key and reports whether it was present.
+func (sl *ConcurrentSkipList[K, V]) Delete(key K) bool {
+	var preds, succs [maxLevel]*concurrentNode[K, V]
+	var victim *concurrentNode[K, V]
+	for {
+		found := sl.find(key, &preds, &succs)
+		if victim == nil {
+			if found == -1 {
+				return false
+			}
+			candidate := succs[found]
+			// Only a fully linked node found at its top level can be claimed.
+			if !candidate.fullyLinked.Load() || len(candidate.next)-1 != found || candidate.marked.Load() {
+				return false
+			}
+			candidate.mu.Lock()
+			if candidate.marked.Load() {
+				candidate.mu.Unlock()
+				return false
+			}
+			candidate.marked.Store(true)
+			victim = candidate
+		}
+		top := len(victim.next)
+		ok, unlock := lockPreds(&preds, top, func(level int) bool {
+			pred := preds[level]
+			return !pred.marked.Load() && pred.next[level].Load() == victim
+		})
+		if !ok {
+			unlock()
+			continue
+		}
+		for level := top - 1; level >= 0; level-- {
+			preds[level].next[level].Store(victim.next[level].Load())
+		}
+		victim.mu.Unlock()
+		unlock()
+		sl.length.Add(-1)
+		return true
+	}
+}
+
+func (sl *ConcurrentSkipList[K, V]) Search(key K) (V, bool) {
+	var preds, succs [maxLevel]*concurrentNode[K, V]
+	if found := sl.find(key, &preds, &succs); found != -1 {
+		n := succs[found]
+		if n.fullyLinked.Load() && !n.marked.Load() {
+			return *n.value.Load(), true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+func (sl *ConcurrentSkipList[K, V]) Contains(key K) bool {
+	_, ok := sl.Search(key)
+	return ok
+}
+
+func (sl *ConcurrentSkipList[K, V]) Len() int {
+	return int(sl.length.Load())
+}
+
+// ascend walks level 0 from start, skipping nodes that are half inserted or
+// logically deleted.
+func (sl *ConcurrentSkipList[K, V]) ascend(start *concurrentNode[K, V], hi *K, yield func(K, V) bool) {
+	for n := start; n != nil; n = n.next[0].Load() {
+		if hi != nil && sl.compare(n.key, *hi) > 0 {
+			return
+		}
+		if !n.fullyLinked.Load() || n.marked.Load() {
+			continue
+		}
+		if !yield(n.key, *n.value.Load()) {
+			return
+		}
+	}
+}
+
+// All yields every entry in ascending key order.
+func (sl *ConcurrentSkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		sl.ascend(sl.head.next[0].Load(), nil, yield)
+	}
+}
+
+// Range yields the entries with lo <= key <= hi in ascending key order.
+func (sl *ConcurrentSkipList[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var preds, succs [maxLevel]*concurrentNode[K, V]
+		sl.find(lo, &preds, &succs)
+		sl.ascend(succs[0], &hi, yield)
+	}
+}
@@ -0,0 +1,125 @@
+package skiplist
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ConcurrentSkipListTestSuite struct {
+	suite.Suite
+	list *ConcurrentSkipList[int, int]
+}
+
+func TestConcurrentSkipListTestSuite(t *testing.T) {
+	suite.Run(t, new(ConcurrentSkipListTestSuite))
+}
+
+func (s *ConcurrentSkipListTestSuite) SetupTest() {
+	s.list = NewConcurrentSkipListWithSeed[int, int](3)
+}
+
+func (s *ConcurrentSkipListTestSuite) TestSequential() {
+	for _, k := range []int{5, 1, 9, 3, 7} {
+		s.True(s.list.Insert(k, k*k))
+	}
+	s.False(s.list.Insert(3, -3))
+	s.Equal(5, s.list.Len())
+
+	value, ok := s.list.Search(3)
+	s.True(ok)
+	s.Equal(-3, value)
+	_, ok = s.list.Search(4)
+	s.False(ok)
+
+	s.True(s.list.Delete(5))
+	s.False(s.list.Delete(5))
+	s.False(s.list.Contains(5))
+	s.Equal(4, s.list.Len())
+
+	s.Equal([]int{1, 3, 7, 9}, keys(s.list.All()))
+	s.Equal([]int{3, 7}, keys(s.list.Range(2, 8)))
+	s.Empty(keys(s.list.Range(10, 20)))
+}
+
+func (s *ConcurrentSkipListTestSuite) TestConcurrentInsertDelete() {
+	const workers, perWorker = 8, 500
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				k := 1000 + i*workers + w
+				s.True(s.list.Insert(k, w))
+				// Contend on a shared range of keys as well.
+				s.list.Insert(i%50, w)
+				if i%2 == 1 {
+					s.True(s.list.Delete(k))
+				}
+			}
+		}(w)
+	}
+	// Readers run alongside the writers.
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				got := keys(s.list.All())
+				s.True(slices.IsSorted(got))
+				s.Len(slices.Compact(slices.Clone(got)), len(got))
+				s.list.Contains(i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	want := map[int]bool{}
+	for k := 0; k < 50; k++ {
+		want[k] = true
+	}
+	for w := 0; w < workers; w++ {
+		for i := 0; i < perWorker; i += 2 {
+			want[1000+i*workers+w] = true
+		}
+	}
+	got := keys(s.list.All())
+	s.Len(got, len(want))
+	s.Equal(len(want), s.list.Len())
+	for _, k := range got {
+		s.True(want[k], "unexpected key %d", k)
+	}
+}
+
+func (s *ConcurrentSkipListTestSuite) TestConcurrentDeleteSameKey() {
+	for k := 0; k < 100; k++ {
+		s.list.Insert(k, k)
+	}
+	var mu sync.Mutex
+	deleted := map[int]int{}
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := 0; k < 100; k++ {
+				if s.list.Delete(k) {
+					mu.Lock()
+					deleted[k]++
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	s.Len(deleted, 100)
+	for k, n := range deleted {
+		s.Equal(1, n, "key %d deleted %d times", k, n)
+	}
+	s.Equal(0, s.list.Len())
+	s.Empty(keys(s.list.All()))
+}
@@ -0,0 +1,231 @@
+package skiplist
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+)
+
+// maxLevel bounds the tower height; with p = 1/4 it comfortably covers 4^32
+// elements.
+const maxLevel = 32
+
+type node[K any, V any] struct {
+	key   K
+	value V
+	next  []*node[K, V]
+	// width[i] is how many level-0 steps next[i] skips, so ranks can be
+	// summed while searching. A nil next counts as one past the last element.
+	width []int
+}
+
+// SkipList is an ordered map kept in a skip list with per-link widths, which
+// gives expected O(log n) search, update and rank queries. It is not safe for
+// concurrent use; see ConcurrentSkipList.
+type SkipList[K any, V any] struct {
+	head    *node[K, V]
+	level   int
+	length  int
+	compare func(a, b K) int
+	rng     *rand.Rand
+}
+
+func NewSkipList[K cmp.Ordered, V any]() *SkipList[K, V] {
+	return NewSkipListFuncWithSeed[K, V](cmp.Compare[K], rand.Uint64())
+}
+
+// NewSkipListWithSeed fixes the tower heights chosen for new elements, so the
+// same sequence of operations always builds the same list.
+func NewSkipListWithSeed[K cmp.Ordered, V any](seed uint64) *SkipList[K, V] {
+	return NewSkipListFuncWithSeed[K, V](cmp.Compare[K], seed)
+}
+
+// NewSkipListFunc orders keys with compare, which returns a negative number,
+// zero or a positive number as a is less than, equal to or greater than b.
+func NewSkipListFunc[K any, V any](compare func(a, b K) int) *SkipList[K, V] {
+	return NewSkipListFuncWithSeed[K, V](compare, rand.Uint64())
+}
+
+func NewSkipListFuncWithSeed[K any, V any](compare func(a, b K) int, seed uint64) *SkipList[K, V] {
+	sl := &SkipList[K, V]{
+		head:    &node[K, V]{next: make([]*node[K, V], maxLevel), width: make([]int, maxLevel)},
+		level:   1,
+		compare: compare,
+		rng:     rand.New(rand.NewPCG(seed, seed)),
+	}
+	sl.head.width[0] = 1
+	return sl
+}
+
+// randomLevel draws a tower height with P(h > k) = 4^-k.
+func randomLevel(rng *rand.Rand) int {
+	level := 1
+	for level < maxLevel && rng.Uint64()&3 == 0 {
+		level++
+	}
+	return level
+}
+
+// Insert stores value under key and reports whether the key is new.
+func (sl *SkipList[K, V]) Insert(key K, value V) bool {
+	var update [maxLevel]*node[K, V]
+	var rank [maxLevel]int
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		if i < sl.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.next[i] != nil && sl.compare(x.next[i].key, key) < 0 {
+			rank[i] += x.width[i]
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	if next := x.next[0]; next != nil && sl.compare(next.key, key) == 0 {
+		next.value = value
+		return false
+	}
+
+	level := randomLevel(sl.rng)
+	for i := sl.level; i < level; i++ {
+		update[i] = sl.head
+		sl.head.next[i] = nil
+		sl.head.width[i] = sl.length + 1
+	}
+	sl.level = max(sl.level, level)
+
+	n := &node[K, V]{key: key, value: value, next: make([]*node[K, V], level), width: make([]int, level)}
+	for i := 0; i < level; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+		n.width[i] = update[i].width[i] - (rank[0] - rank[i])
+		update[i].width[i] = rank[0] - rank[i] + 1
+	}
+	for i := level; i < sl.level; i++ {
+		update[i].width[i]++
+	}
+	sl.length++
+	return true
+}
+
+// Delete removes key and reports whether it was present.
+func (sl *SkipList[K, V]) Delete(key K) bool {
+	var update [maxLevel]*node[K, V]
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && sl.compare(x.next[i].key, key) < 0 {
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	target := x.next[0]
+	if target == nil || sl.compare(target.key, key) != 0 {
+		return false
+	}
+	for i := 0; i < sl.level; i++ {
+		if update[i].next[i] == target {
+			update[i].width[i] += target.width[i] - 1
+			update[i].next[i] = target.next[i]
+		} else {
+			update[i].width[i]--
+		}
+	}
+	for sl.level > 1 && sl.head.next[sl.level-1] == nil {
+		sl.level--
+	}
+	sl.length--
+	return true
+}
+
+// seek returns the last node with a key less than key, or the head.
+func (sl *SkipList[K, V]) seek(key K) *node[K, V] {
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && sl.compare(x.next[i].key, key) < 0 {
+			x = x.next[i]
+		}
+	}
+	return x
+}
+
+func (sl *SkipList[K, V]) Search(key K) (V, bool) {
+	if n := sl.seek(key).next[0]; n != nil && sl.compare(n.key, key) == 0 {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (sl *SkipList[K, V]) Contains(key K) bool {
+	_, ok := sl.Search(key)
+	return ok
+}
+
+// Rank returns how many keys are less than key.
+func (sl *SkipList[K, V]) Rank(key K) int {
+	rank := 0
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && sl.compare(x.next[i].key, key) < 0 {
+			rank += x.width[i]
+			x = x.next[i]
+		}
+	}
+	return rank
+}
+
+// Select returns the entry with the k-th smallest key, counting from zero.
+func (sl *SkipList[K, V]) Select(k int) (K, V, bool) {
+	if k < 0 || k >= sl.length {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	pos := 0
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && pos+x.width[i] <= k+1 {
+			pos += x.width[i]
+			x = x.next[i]
+		}
+	}
+	return x.key, x.value, true
+}
+
+func (sl *SkipList[K, V]) Len() int {
+	return sl.length
+}
+
+func (sl *SkipList[K, V]) IsEmpty() bool {
+	return sl.length == 0
+}
+
+func (sl *SkipList[K, V]) Clear() {
+	clear(sl.head.next)
+	clear(sl.head.width)
+	sl.head.width[0] = 1
+	sl.level = 1
+	sl.length = 0
+}
+
+// All yields every entry in ascending key order.
+func (sl *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := sl.head.next[0]; n != nil; n = n.next[0] {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Range yields the entries with lo <= key <= hi in ascending key order.
+func (sl *SkipList[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := sl.seek(lo).next[0]; n != nil && sl.compare(n.key, hi) <= 0; n = n.next[0] {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
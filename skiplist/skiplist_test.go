@@ -0,0 +1,177 @@
+package skiplist
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SkipListTestSuite struct {
+	suite.Suite
+	list *SkipList[int, string]
+}
+
+func TestSkipListTestSuite(t *testing.T) {
+	suite.Run(t, new(SkipListTestSuite))
+}
+
+func (s *SkipListTestSuite) SetupTest() {
+	s.list = NewSkipListWithSeed[int, string](42)
+	for _, k := range []int{30, 10, 50, 20, 40} {
+		s.list.Insert(k, strings.Repeat("#", k/10))
+	}
+}
+
+func keys[K any, V any](seq func(func(K, V) bool)) []K {
+	result := []K{}
+	for k := range seq {
+		result = append(result, k)
+	}
+	return result
+}
+
+// towers records the height of every element, which depends only on the seed
+// and the sequence of operations.
+func towers[K any, V any](sl *SkipList[K, V]) []int {
+	heights := []int{}
+	for n := sl.head.next[0]; n != nil; n = n.next[0] {
+		heights = append(heights, len(n.next))
+	}
+	return heights
+}
+
+// checkWidths verifies every link width against level-0 positions.
+func (s *SkipListTestSuite) checkWidths(sl *SkipList[int, string]) {
+	position := map[*node[int, string]]int{sl.head: 0}
+	i := 0
+	for n := sl.head.next[0]; n != nil; n = n.next[0] {
+		i++
+		position[n] = i
+	}
+	s.Equal(sl.Len(), i)
+	for n := sl.head; n != nil; n = n.next[0] {
+		for level := 0; level < min(len(n.next), sl.level); level++ {
+			end := sl.Len() + 1
+			if next := n.next[level]; next != nil {
+				end = position[next]
+			}
+			s.Equal(end-position[n], n.width[level], "level %d", level)
+		}
+	}
+}
+
+func (s *SkipListTestSuite) TestInsertSearchDelete() {
+	s.Equal(5, s.list.Len())
+	s.False(s.list.Insert(20, "twenty"))
+	s.Equal(5, s.list.Len())
+
+	value, ok := s.list.Search(20)
+	s.True(ok)
+	s.Equal("twenty", value)
+	_, ok = s.list.Search(25)
+	s.False(ok)
+	s.True(s.list.Contains(50))
+
+	s.True(s.list.Delete(30))
+	s.False(s.list.Delete(30))
+	s.False(s.list.Contains(30))
+	s.Equal([]int{10, 20, 40, 50}, keys(s.list.All()))
+	s.checkWidths(s.list)
+
+	s.list.Clear()
+	s.True(s.list.IsEmpty())
+	s.Empty(keys(s.list.All()))
+	s.True(s.list.Insert(1, "x"))
+	s.checkWidths(s.list)
+}
+
+func (s *SkipListTestSuite) TestRange() {
+	s.Equal([]int{20, 30, 40}, keys(s.list.Range(15, 45)))
+	s.Equal([]int{10, 20}, keys(s.list.Range(10, 20)))
+	s.Empty(keys(s.list.Range(21, 29)))
+	s.Empty(keys(s.list.Range(40, 20)))
+
+	seen := []int{}
+	for k := range s.list.Range(0, 100) {
+		seen = append(seen, k)
+		if k == 30 {
+			break
+		}
+	}
+	s.Equal([]int{10, 20, 30}, seen)
+}
+
+func (s *SkipListTestSuite) TestRankAndSelect() {
+	for i, want := range []int{10, 20, 30, 40, 50} {
+		s.Equal(i, s.list.Rank(want))
+		key, _, ok := s.list.Select(i)
+		s.True(ok)
+		s.Equal(want, key)
+	}
+	s.Equal(0, s.list.Rank(5))
+	s.Equal(2, s.list.Rank(25))
+	s.Equal(5, s.list.Rank(99))
+	_, _, ok := s.list.Select(5)
+	s.False(ok)
+	_, _, ok = s.list.Select(-1)
+	s.False(ok)
+}
+
+func (s *SkipListTestSuite) TestSeedIsDeterministic() {
+	build := func(seed uint64) *SkipList[int, string] {
+		sl := NewSkipListWithSeed[int, string](seed)
+		for i := 0; i < 200; i++ {
+			sl.Insert((i*37)%200, "")
+		}
+		return sl
+	}
+	s.Equal(towers(build(7)), towers(build(7)))
+	s.NotEqual(towers(build(7)), towers(build(8)))
+}
+
+func (s *SkipListTestSuite) TestRandomOperations() {
+	sl := NewSkipListWithSeed[int, string](1)
+	reference := map[int]string{}
+	rng := rand.New(rand.NewPCG(5, 6))
+	for i := 0; i < 5000; i++ {
+		k := rng.IntN(300)
+		if rng.IntN(3) == 0 {
+			_, present := reference[k]
+			s.Equal(present, sl.Delete(k))
+			delete(reference, k)
+		} else {
+			_, present := reference[k]
+			v := strings.Repeat("v", i%4)
+			s.Equal(!present, sl.Insert(k, v))
+			reference[k] = v
+		}
+	}
+	s.checkWidths(sl)
+
+	want := make([]int, 0, len(reference))
+	for k := range reference {
+		want = append(want, k)
+	}
+	slices.Sort(want)
+	s.Equal(want, keys(sl.All()))
+	for i, k := range want {
+		s.Equal(i, sl.Rank(k))
+		key, value, _ := sl.Select(i)
+		s.Equal(k, key)
+		s.Equal(reference[k], value)
+	}
+}
+
+func (s *SkipListTestSuite) TestComparator() {
+	sl := NewSkipListFunc[string, int](func(a, b string) int { return strings.Compare(b, a) })
+	sl.Insert("a", 1)
+	sl.Insert("c", 3)
+	sl.Insert("b", 2)
+
+	s.Equal([]string{"c", "b", "a"}, keys(sl.All()))
+	s.Equal(1, sl.Rank("b"))
+	s.Equal([]string{"c", "b"}, keys(sl.Range("z", "b")))
+}
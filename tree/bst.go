@@ -1,41 +1,97 @@
 package tree
 
-import "fmt"
+import (
+	"cmp"
+	"fmt"
+	"math"
+)
 
-type BSTNode[T comparable] struct {
+type BSTNode[T any] struct {
 	Value T
 	Left  *BSTNode[T]
 	Right *BSTNode[T]
+	count int
+	size  int
 }
 
-type bstRequest[T comparable] struct {
-	action    string
-	value     T
-	replyChan chan interface{}
+// subtreeSize returns the number of values stored under node, counting
+// multiset duplicates.
+func subtreeSize[T any](node *BSTNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
+func (node *BSTNode[T]) updateSize() {
+	node.size = node.count + subtreeSize(node.Left) + subtreeSize(node.Right)
+}
+
+type bstRequest[T any] struct {
+	action     string
+	value      T
+	other      T
+	index      int
+	percentile float64
+	replyChan  chan interface{}
 }
 
-type Ordered interface {
-	~int | ~int8 | ~int16 | ~int32 | ~int64 |
-		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
-		~float32 | ~float64 | ~string
+// bstResult and bstMatch are the replies to requests that may have no value.
+// They keep the payload typed, since T may itself be an interface or an error.
+type bstResult[T any] struct {
+	value T
+	err   error
 }
 
-type GenericBST[T Ordered] struct {
-	bstChan chan bstRequest[T]
-	root    *BSTNode[T]
-	size    int
+type bstMatch[T any] struct {
+	value T
+	found bool
 }
 
-func NewGenericBST[T Ordered]() *GenericBST[T] {
+// Ordered is the set of types NewGenericBST orders with cmp.Compare.
+//
+// Deprecated: use cmp.Ordered.
+type Ordered = cmp.Ordered
+
+type GenericBST[T any] struct {
+	bstChan  chan bstRequest[T]
+	root     *BSTNode[T]
+	size     int
+	compare  func(a, b T) int
+	multiset bool
+}
+
+func NewGenericBST[T cmp.Ordered]() *GenericBST[T] {
+	return NewGenericBSTFunc(cmp.Compare[T])
+}
+
+// NewGenericBSTFunc orders values with compare, which returns a negative
+// number, zero or a positive number as a is less than, equal to or greater
+// than b. Values comparing equal are treated as duplicates.
+func NewGenericBSTFunc[T any](compare func(a, b T) int) *GenericBST[T] {
 	bst := &GenericBST[T]{
 		bstChan: make(chan bstRequest[T]),
 		root:    nil,
 		size:    0,
+		compare: compare,
 	}
 	go bst.manageBST()
 	return bst
 }
 
+// NewGenericMultisetBST keeps duplicate values, counted per node, instead of
+// ignoring them. Size, traversals and order statistics include every copy.
+// Values that compare equal share the node of the first one inserted.
+func NewGenericMultisetBST[T cmp.Ordered]() *GenericBST[T] {
+	return NewGenericMultisetBSTFunc(cmp.Compare[T])
+}
+
+func NewGenericMultisetBSTFunc[T any](compare func(a, b T) int) *GenericBST[T] {
+	bst := NewGenericBSTFunc(compare)
+	bst.multiset = true
+	return bst
+}
+
 func (bst *GenericBST[T]) manageBST() {
 	for req := range bst.bstChan {
 		switch req.action {
@@ -44,22 +100,18 @@ func (bst *GenericBST[T]) manageBST() {
 			req.replyChan <- true
 		case "delete":
 			req.replyChan <- bst.delete(req.value)
+		case "deleteAll":
+			req.replyChan <- bst.deleteAll(req.value)
+		case "count":
+			req.replyChan <- bst.count(req.value)
 		case "search":
 			req.replyChan <- bst.search(req.value)
 		case "min":
 			value, err := bst.min()
-			if err != nil {
-				req.replyChan <- err
-			} else {
-				req.replyChan <- value
-			}
+			req.replyChan <- bstResult[T]{value, err}
 		case "max":
 			value, err := bst.max()
-			if err != nil {
-				req.replyChan <- err
-			} else {
-				req.replyChan <- value
-			}
+			req.replyChan <- bstResult[T]{value, err}
 		case "inorder":
 			req.replyChan <- bst.inOrder()
 		case "preorder":
@@ -79,78 +131,149 @@ func (bst *GenericBST[T]) manageBST() {
 			req.replyChan <- true
 		case "validate":
 			req.replyChan <- bst.validate()
+		case "floor", "ceiling", "predecessor", "successor":
+			var value T
+			var found bool
+			switch req.action {
+			case "floor":
+				value, found = bst.floor(req.value)
+			case "ceiling":
+				value, found = bst.ceiling(req.value)
+			case "predecessor":
+				value, found = bst.predecessor(req.value)
+			case "successor":
+				value, found = bst.successor(req.value)
+			}
+			req.replyChan <- bstMatch[T]{value, found}
+		case "rangeCount":
+			req.replyChan <- bst.rangeCount(req.value, req.other)
+		case "range":
+			req.replyChan <- bst.rangeValues(req.value, req.other)
+		case "select", "median", "percentile":
+			var value T
+			var err error
+			switch req.action {
+			case "select":
+				value, err = bst.selectAt(req.index)
+			case "median":
+				value, err = bst.median()
+			case "percentile":
+				value, err = bst.percentileOf(req.percentile)
+			}
+			req.replyChan <- bstResult[T]{value, err}
+		case "rank":
+			req.replyChan <- bst.rank(req.value)
 		}
 	}
 }
 
 func (bst *GenericBST[T]) insert(value T) {
-	if bst.root == nil {
-		bst.root = &BSTNode[T]{Value: value}
-		bst.size++
-		return
-	}
-	bst.insertHelper(bst.root, value)
+	bst.root = bst.insertHelper(bst.root, value)
 }
 
 func (bst *GenericBST[T]) insertHelper(node *BSTNode[T], value T) *BSTNode[T] {
 	if node == nil {
 		bst.size++
-		return &BSTNode[T]{Value: value}
+		return &BSTNode[T]{Value: value, count: 1, size: 1}
 	}
 
-	if value < node.Value {
+	if c := bst.compare(value, node.Value); c < 0 {
 		node.Left = bst.insertHelper(node.Left, value)
-	} else if value > node.Value {
+	} else if c > 0 {
 		node.Right = bst.insertHelper(node.Right, value)
+	} else if bst.multiset {
+		node.count++
+		bst.size++
 	}
 
+	node.updateSize()
 	return node
 }
 
+// delete removes one occurrence of value.
 func (bst *GenericBST[T]) delete(value T) bool {
+	return bst.remove(value, false) > 0
+}
+
+// deleteAll removes every occurrence of value and returns how many there were.
+func (bst *GenericBST[T]) deleteAll(value T) int {
+	return bst.remove(value, true)
+}
+
+func (bst *GenericBST[T]) remove(value T, all bool) int {
 	if bst.root == nil {
-		return false
+		return 0
 	}
 
-	var deleted bool
-	bst.root, deleted = bst.deleteHelper(bst.root, value)
-	if deleted {
-		bst.size--
-	}
-	return deleted
+	var removed int
+	bst.root, removed = bst.deleteHelper(bst.root, value, all)
+	bst.size -= removed
+	return removed
 }
 
-func (bst *GenericBST[T]) deleteHelper(node *BSTNode[T], value T) (*BSTNode[T], bool) {
+func (bst *GenericBST[T]) deleteHelper(node *BSTNode[T], value T, all bool) (*BSTNode[T], int) {
 	if node == nil {
-		return nil, false
+		return nil, 0
 	}
 
-	var deleted bool
+	var removed int
 
-	if value < node.Value {
-		node.Left, deleted = bst.deleteHelper(node.Left, value)
-	} else if value > node.Value {
-		node.Right, deleted = bst.deleteHelper(node.Right, value)
+	if c := bst.compare(value, node.Value); c < 0 {
+		node.Left, removed = bst.deleteHelper(node.Left, value, all)
+	} else if c > 0 {
+		node.Right, removed = bst.deleteHelper(node.Right, value, all)
 	} else {
-		deleted = true
+		if !all && node.count > 1 {
+			node.count--
+			node.updateSize()
+			return node, 1
+		}
+		removed = node.count
 
 		if node.Left == nil && node.Right == nil {
-			return nil, true
+			return nil, removed
 		}
 
 		if node.Left == nil {
-			return node.Right, true
+			return node.Right, removed
 		}
 		if node.Right == nil {
-			return node.Left, true
+			return node.Left, removed
 		}
 
 		successor := bst.findMin(node.Right)
-		node.Value = successor.Value
-		node.Right, _ = bst.deleteHelper(node.Right, successor.Value)
+		node.Value, node.count = successor.Value, successor.count
+		node.Right, _ = bst.deleteHelper(node.Right, successor.Value, true)
 	}
 
-	return node, deleted
+	node.updateSize()
+	return node, removed
+}
+
+// appendCopies appends node's value once per stored occurrence.
+func appendCopies[T any](result []T, node *BSTNode[T]) []T {
+	for i := 0; i < node.count; i++ {
+		result = append(result, node.Value)
+	}
+	return result
+}
+
+// count returns how many times value is stored; at most one outside
+// multiset mode.
+func (bst *GenericBST[T]) count(value T) int {
+	node := bst.root
+	for node != nil {
+		c := bst.compare(value, node.Value)
+		if c == 0 {
+			return node.count
+		}
+		if c < 0 {
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+	return 0
 }
 
 func (bst *GenericBST[T]) search(value T) bool {
@@ -162,9 +285,9 @@ func (bst *GenericBST[T]) searchHelper(node *BSTNode[T], value T) bool {
 		return false
 	}
 
-	if value == node.Value {
+	if c := bst.compare(value, node.Value); c == 0 {
 		return true
-	} else if value < node.Value {
+	} else if c < 0 {
 		return bst.searchHelper(node.Left, value)
 	} else {
 		return bst.searchHelper(node.Right, value)
@@ -212,7 +335,7 @@ func (bst *GenericBST[T]) inOrderHelper(node *BSTNode[T], result *[]T) {
 		return
 	}
 	bst.inOrderHelper(node.Left, result)
-	*result = append(*result, node.Value)
+	*result = appendCopies(*result, node)
 	bst.inOrderHelper(node.Right, result)
 }
 
@@ -226,7 +349,7 @@ func (bst *GenericBST[T]) preOrderHelper(node *BSTNode[T], result *[]T) {
 	if node == nil {
 		return
 	}
-	*result = append(*result, node.Value)
+	*result = appendCopies(*result, node)
 	bst.preOrderHelper(node.Left, result)
 	bst.preOrderHelper(node.Right, result)
 }
@@ -243,7 +366,7 @@ func (bst *GenericBST[T]) postOrderHelper(node *BSTNode[T], result *[]T) {
 	}
 	bst.postOrderHelper(node.Left, result)
 	bst.postOrderHelper(node.Right, result)
-	*result = append(*result, node.Value)
+	*result = appendCopies(*result, node)
 }
 
 func (bst *GenericBST[T]) levelOrder() []T {
@@ -257,7 +380,7 @@ func (bst *GenericBST[T]) levelOrder() []T {
 	for len(queue) > 0 {
 		node := queue[0]
 		queue = queue[1:]
-		result = append(result, node.Value)
+		result = appendCopies(result, node)
 
 		if node.Left != nil {
 			queue = append(queue, node.Left)
@@ -305,11 +428,11 @@ func (bst *GenericBST[T]) validateHelper(node *BSTNode[T], min, max *T) bool {
 		return true
 	}
 
-	if min != nil && node.Value <= *min {
+	if min != nil && bst.compare(node.Value, *min) <= 0 {
 		return false
 	}
 
-	if max != nil && node.Value >= *max {
+	if max != nil && bst.compare(node.Value, *max) >= 0 {
 		return false
 	}
 
@@ -318,3 +441,146 @@ func (bst *GenericBST[T]) validateHelper(node *BSTNode[T], min, max *T) bool {
 
 	return leftValid && rightValid
 }
+
+// closest walks one root-to-leaf path and returns the nearest value on the
+// requested side of value: below selects the greatest value less than it,
+// otherwise the least value greater than it. inclusive also accepts value
+// itself.
+func (bst *GenericBST[T]) closest(value T, below, inclusive bool) (T, bool) {
+	var best *BSTNode[T]
+	for node := bst.root; node != nil; {
+		c := bst.compare(value, node.Value)
+		if c == 0 && inclusive {
+			return node.Value, true
+		}
+		if (below && c > 0) || (!below && c < 0) {
+			best = node
+		}
+		if c > 0 {
+			node = node.Right
+		} else if c < 0 {
+			node = node.Left
+		} else if below {
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+	if best == nil {
+		var zero T
+		return zero, false
+	}
+	return best.Value, true
+}
+
+func (bst *GenericBST[T]) floor(value T) (T, bool) {
+	return bst.closest(value, true, true)
+}
+
+func (bst *GenericBST[T]) ceiling(value T) (T, bool) {
+	return bst.closest(value, false, true)
+}
+
+func (bst *GenericBST[T]) predecessor(value T) (T, bool) {
+	return bst.closest(value, true, false)
+}
+
+func (bst *GenericBST[T]) successor(value T) (T, bool) {
+	return bst.closest(value, false, false)
+}
+
+func (bst *GenericBST[T]) rangeCount(lo, hi T) int {
+	if bst.compare(lo, hi) > 0 {
+		return 0
+	}
+	return bst.countBelow(hi, true) - bst.countBelow(lo, false)
+}
+
+func (bst *GenericBST[T]) rangeValues(lo, hi T) []T {
+	result := make([]T, 0)
+	bst.rangeHelper(bst.root, lo, hi, func(node *BSTNode[T]) { result = appendCopies(result, node) })
+	return result
+}
+
+// rangeHelper visits the values in [lo, hi] in order, skipping subtrees that
+// lie entirely outside the range.
+func (bst *GenericBST[T]) rangeHelper(node *BSTNode[T], lo, hi T, visit func(*BSTNode[T])) {
+	if node == nil {
+		return
+	}
+	aboveLo := bst.compare(node.Value, lo) >= 0
+	belowHi := bst.compare(node.Value, hi) <= 0
+	if aboveLo {
+		bst.rangeHelper(node.Left, lo, hi, visit)
+	}
+	if aboveLo && belowHi {
+		visit(node)
+	}
+	if belowHi {
+		bst.rangeHelper(node.Right, lo, hi, visit)
+	}
+}
+
+// countBelow returns how many values are less than value, or less than or
+// equal to it when inclusive, in O(h) using subtree sizes.
+func (bst *GenericBST[T]) countBelow(value T, inclusive bool) int {
+	count := 0
+	for node := bst.root; node != nil; {
+		c := bst.compare(value, node.Value)
+		if c > 0 || (c == 0 && inclusive) {
+			count += subtreeSize(node.Left) + node.count
+			node = node.Right
+		} else {
+			node = node.Left
+		}
+	}
+	return count
+}
+
+func (bst *GenericBST[T]) rank(value T) int {
+	return bst.countBelow(value, false)
+}
+
+// selectAt returns the value at zero-based position k in sorted order.
+func (bst *GenericBST[T]) selectAt(k int) (T, error) {
+	if k < 0 || k >= bst.size {
+		var zero T
+		return zero, fmt.Errorf("index %d out of range [0, %d)", k, bst.size)
+	}
+	node := bst.root
+	for {
+		left := subtreeSize(node.Left)
+		switch {
+		case k < left:
+			node = node.Left
+		case k >= left+node.count:
+			k -= left + node.count
+			node = node.Right
+		default:
+			return node.Value, nil
+		}
+	}
+}
+
+// median returns the lower median, as values of T cannot be averaged.
+func (bst *GenericBST[T]) median() (T, error) {
+	if bst.root == nil {
+		var zero T
+		return zero, fmt.Errorf("tree is empty")
+	}
+	return bst.selectAt((bst.size - 1) / 2)
+}
+
+// percentileOf uses the nearest-rank method: the smallest value such that at
+// least p percent of the values are less than or equal to it.
+func (bst *GenericBST[T]) percentileOf(p float64) (T, error) {
+	var zero T
+	if bst.root == nil {
+		return zero, fmt.Errorf("tree is empty")
+	}
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		return zero, fmt.Errorf("percentile %v out of range [0, 100]", p)
+	}
+	rank := int(math.Ceil(p / 100 * float64(bst.size)))
+	return bst.selectAt(max(rank, 1) - 1)
+}
@@ -1,8 +1,13 @@
 package tree
 
 import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"strings"
 	"testing"
 
+	"github.com/raj1kshtz/go-structurarium/datastructure_helper"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -164,3 +169,241 @@ func (s *GenericBSTTestSuite) TestStringBST() {
 	result := strBST.inOrder()
 	s.Equal([]string{"ant", "cat", "dog", "elephant"}, result)
 }
+
+func comparePeople(a, b datastructure_helper.Person) int {
+	return cmp.Compare(a.ID, b.ID)
+}
+
+func (s *GenericBSTTestSuite) TestCompositeKeys() {
+	people := NewGenericBSTFunc(comparePeople)
+	people.insert(datastructure_helper.Person{ID: 3, Name: "Carol"})
+	people.insert(datastructure_helper.Person{ID: 1, Name: "Alice"})
+	people.insert(datastructure_helper.Person{ID: 2, Name: "Bob"})
+	people.insert(datastructure_helper.Person{ID: 2, Name: "Bobby"})
+
+	s.Equal(3, people.size)
+	s.Equal([]datastructure_helper.Person{{ID: 1, Name: "Alice"}, {ID: 2, Name: "Bob"}, {ID: 3, Name: "Carol"}}, people.inOrder())
+	// Only the key takes part in comparisons.
+	s.True(people.search(datastructure_helper.Person{ID: 3}))
+	s.False(people.search(datastructure_helper.Person{ID: 4, Name: "Carol"}))
+	s.True(people.delete(datastructure_helper.Person{ID: 1}))
+	s.True(people.validate())
+
+	oldest, err := people.max()
+	s.NoError(err)
+	s.Equal("Carol", oldest.Name)
+}
+
+func (s *GenericBSTTestSuite) TestReversedOrdering() {
+	reversed := NewGenericBSTFunc(func(a, b int) int { return cmp.Compare(b, a) })
+	for _, v := range []int{5, 3, 7, 2, 4} {
+		reversed.insert(v)
+	}
+
+	s.Equal([]int{7, 5, 4, 3, 2}, reversed.inOrder())
+	s.True(reversed.validate())
+	first, _ := reversed.min()
+	s.Equal(7, first)
+	s.True(reversed.delete(5))
+	s.Equal([]int{7, 4, 3, 2}, reversed.inOrder())
+}
+
+func (s *GenericBSTTestSuite) TestCaseInsensitiveStrings() {
+	words := NewGenericBSTFunc(func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	words.insert("banana")
+	words.insert("Apple")
+	words.insert("APPLE")
+	words.insert("cherry")
+
+	s.Equal([]string{"Apple", "banana", "cherry"}, words.inOrder())
+	s.True(words.search("BANANA"))
+}
+
+func (s *GenericBSTTestSuite) TestNavigation() {
+	for _, v := range []int{50, 30, 70, 20, 40, 60, 80} {
+		s.intBST.insert(v)
+	}
+
+	cases := []struct {
+		name  string
+		find  func(int) (int, bool)
+		query int
+		want  int
+		found bool
+	}{
+		{"floor exact", s.intBST.floor, 40, 40, true},
+		{"floor between", s.intBST.floor, 45, 40, true},
+		{"floor below min", s.intBST.floor, 10, 0, false},
+		{"ceiling exact", s.intBST.ceiling, 60, 60, true},
+		{"ceiling between", s.intBST.ceiling, 45, 50, true},
+		{"ceiling above max", s.intBST.ceiling, 90, 0, false},
+		{"predecessor present", s.intBST.predecessor, 50, 40, true},
+		{"predecessor absent", s.intBST.predecessor, 55, 50, true},
+		{"predecessor of min", s.intBST.predecessor, 20, 0, false},
+		{"successor present", s.intBST.successor, 40, 50, true},
+		{"successor leaf", s.intBST.successor, 60, 70, true},
+		{"successor of max", s.intBST.successor, 80, 0, false},
+	}
+	for _, tc := range cases {
+		got, found := tc.find(tc.query)
+		s.Equal(tc.found, found, tc.name)
+		s.Equal(tc.want, got, tc.name)
+	}
+
+	empty := NewGenericBST[int]()
+	_, found := empty.floor(1)
+	s.False(found)
+}
+
+func (s *GenericBSTTestSuite) TestRange() {
+	for _, v := range []int{50, 30, 70, 20, 40, 60, 80} {
+		s.intBST.insert(v)
+	}
+
+	s.Equal([]int{30, 40, 50, 60}, s.intBST.rangeValues(25, 65))
+	s.Equal([]int{20, 30}, s.intBST.rangeValues(20, 30))
+	s.Equal(7, s.intBST.rangeCount(0, 100))
+	s.Equal(1, s.intBST.rangeCount(80, 80))
+	s.Equal(0, s.intBST.rangeCount(41, 49))
+	s.Empty(s.intBST.rangeValues(60, 40))
+}
+
+func (s *GenericBSTTestSuite) TestOrderStatistics() {
+	for _, v := range []int{50, 30, 70, 20, 40, 60, 80, 10} {
+		s.intBST.insert(v)
+	}
+
+	for k, want := range []int{10, 20, 30, 40, 50, 60, 70, 80} {
+		got, err := s.intBST.selectAt(k)
+		s.NoError(err)
+		s.Equal(want, got)
+		s.Equal(k, s.intBST.rank(want))
+	}
+	_, err := s.intBST.selectAt(8)
+	s.Error(err)
+	_, err = s.intBST.selectAt(-1)
+	s.Error(err)
+	s.Equal(0, s.intBST.rank(5))
+	s.Equal(3, s.intBST.rank(35))
+	s.Equal(8, s.intBST.rank(99))
+
+	median, err := s.intBST.median()
+	s.NoError(err)
+	s.Equal(40, median)
+
+	for p, want := range map[float64]int{0: 10, 10: 10, 25: 20, 50: 40, 90: 80, 100: 80} {
+		got, err := s.intBST.percentileOf(p)
+		s.NoError(err)
+		s.Equal(want, got, "p%v", p)
+	}
+	_, err = s.intBST.percentileOf(101)
+	s.Error(err)
+
+	s.intBST.delete(50)
+	median, _ = s.intBST.median()
+	s.Equal(40, median)
+	s.Equal(4, s.intBST.rank(60))
+
+	s.intBST.clear()
+	_, err = s.intBST.median()
+	s.Error(err)
+}
+
+func (s *GenericBSTTestSuite) TestSubtreeSizesStayConsistent() {
+	rng := rand.New(rand.NewPCG(3, 5))
+	present := map[int]bool{}
+	var check func(node *BSTNode[int]) int
+	check = func(node *BSTNode[int]) int {
+		if node == nil {
+			return 0
+		}
+		size := 1 + check(node.Left) + check(node.Right)
+		s.Equal(size, node.size)
+		return size
+	}
+	for i := 0; i < 500; i++ {
+		v := rng.IntN(100)
+		if rng.IntN(2) == 0 {
+			s.intBST.delete(v)
+			delete(present, v)
+		} else {
+			s.intBST.insert(v)
+			present[v] = true
+		}
+	}
+	s.Equal(len(present), check(s.intBST.root))
+
+	sorted := s.intBST.inOrder()
+	s.True(slices.IsSorted(sorted))
+	for k, v := range sorted {
+		got, _ := s.intBST.selectAt(k)
+		s.Equal(v, got)
+	}
+}
+
+func (s *GenericBSTTestSuite) TestSetModeIgnoresDuplicates() {
+	s.intBST.insert(5)
+	s.intBST.insert(5)
+
+	s.Equal(1, s.intBST.size)
+	s.Equal(1, s.intBST.count(5))
+	s.Equal(0, s.intBST.count(6))
+	s.Equal(1, s.intBST.deleteAll(5))
+	s.Equal(0, s.intBST.size)
+}
+
+func (s *GenericBSTTestSuite) TestMultiset() {
+	ms := NewGenericMultisetBST[int]()
+	for _, v := range []int{5, 3, 5, 8, 3, 5, 1} {
+		ms.insert(v)
+	}
+
+	s.Equal(7, ms.size)
+	s.Equal(3, ms.count(5))
+	s.Equal(2, ms.count(3))
+	s.Equal(0, ms.count(4))
+	s.Equal([]int{1, 3, 3, 5, 5, 5, 8}, ms.inOrder())
+	s.Len(ms.levelOrder(), 7)
+	s.True(ms.validate())
+
+	// Order statistics count every copy.
+	s.Equal(3, ms.rank(5))
+	s.Equal(6, ms.rank(8))
+	fifth, _ := ms.selectAt(4)
+	s.Equal(5, fifth)
+	median, _ := ms.median()
+	s.Equal(5, median)
+	s.Equal(5, ms.rangeCount(3, 5))
+	s.Equal([]int{3, 3, 5, 5, 5}, ms.rangeValues(2, 6))
+
+	s.True(ms.delete(5))
+	s.Equal(2, ms.count(5))
+	s.Equal(6, ms.size)
+
+	// Deleting a node with two children moves the successor's count too.
+	s.Equal(2, ms.deleteAll(3))
+	s.Equal(0, ms.deleteAll(3))
+	s.False(ms.delete(3))
+	s.Equal([]int{1, 5, 5, 8}, ms.inOrder())
+	s.Equal(4, ms.size)
+	s.Equal(4, subtreeSize(ms.root))
+
+	ms.insert(8)
+	s.Equal(2, ms.deleteAll(8))
+	s.Equal([]int{1, 5, 5}, ms.inOrder())
+}
+
+func (s *GenericBSTTestSuite) TestMultisetKeepsCountsOnRestructure() {
+	ms := NewGenericMultisetBST[int]()
+	for _, v := range []int{50, 30, 70, 60, 80, 60, 60, 80} {
+		ms.insert(v)
+	}
+	// 50 has two children; its successor 60 carries three copies.
+	s.Equal(1, ms.deleteAll(50))
+	s.Equal(3, ms.count(60))
+	s.Equal(2, ms.count(80))
+	s.Equal([]int{30, 60, 60, 60, 70, 80, 80}, ms.inOrder())
+	s.Equal(7, subtreeSize(ms.root))
+}
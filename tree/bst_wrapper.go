@@ -1,27 +1,64 @@
 package tree
 
-type BSTWrapper[T Ordered] struct {
+import "cmp"
+
+type BSTWrapper[T any] struct {
 	bst *GenericBST[T]
 }
 
-func NewBSTWrapper[T Ordered]() *BSTWrapper[T] {
+func NewBSTWrapper[T cmp.Ordered]() *BSTWrapper[T] {
 	return &BSTWrapper[T]{
 		bst: NewGenericBST[T](),
 	}
 }
 
+// NewBSTWrapperFunc orders values with compare; see NewGenericBSTFunc.
+func NewBSTWrapperFunc[T any](compare func(a, b T) int) *BSTWrapper[T] {
+	return &BSTWrapper[T]{
+		bst: NewGenericBSTFunc(compare),
+	}
+}
+
+// NewMultisetBSTWrapper keeps duplicates; see NewGenericMultisetBST.
+func NewMultisetBSTWrapper[T cmp.Ordered]() *BSTWrapper[T] {
+	return &BSTWrapper[T]{
+		bst: NewGenericMultisetBST[T](),
+	}
+}
+
+func NewMultisetBSTWrapperFunc[T any](compare func(a, b T) int) *BSTWrapper[T] {
+	return &BSTWrapper[T]{
+		bst: NewGenericMultisetBSTFunc(compare),
+	}
+}
+
 func (bw *BSTWrapper[T]) Insert(value T) {
 	replyChan := make(chan interface{})
 	bw.bst.bstChan <- bstRequest[T]{action: "insert", value: value, replyChan: replyChan}
 	<-replyChan
 }
 
+// Delete removes one occurrence of value.
 func (bw *BSTWrapper[T]) Delete(value T) bool {
 	replyChan := make(chan interface{})
 	bw.bst.bstChan <- bstRequest[T]{action: "delete", value: value, replyChan: replyChan}
 	return (<-replyChan).(bool)
 }
 
+// DeleteAll removes every occurrence of value and returns how many there were.
+func (bw *BSTWrapper[T]) DeleteAll(value T) int {
+	replyChan := make(chan interface{})
+	bw.bst.bstChan <- bstRequest[T]{action: "deleteAll", value: value, replyChan: replyChan}
+	return (<-replyChan).(int)
+}
+
+// Count returns how many times value is stored.
+func (bw *BSTWrapper[T]) Count(value T) int {
+	replyChan := make(chan interface{})
+	bw.bst.bstChan <- bstRequest[T]{action: "count", value: value, replyChan: replyChan}
+	return (<-replyChan).(int)
+}
+
 func (bw *BSTWrapper[T]) Search(value T) bool {
 	replyChan := make(chan interface{})
 	bw.bst.bstChan <- bstRequest[T]{action: "search", value: value, replyChan: replyChan}
@@ -31,23 +68,15 @@ func (bw *BSTWrapper[T]) Search(value T) bool {
 func (bw *BSTWrapper[T]) Min() (T, error) {
 	replyChan := make(chan interface{})
 	bw.bst.bstChan <- bstRequest[T]{action: "min", replyChan: replyChan}
-	result := <-replyChan
-	if err, ok := result.(error); ok {
-		var zero T
-		return zero, err
-	}
-	return result.(T), nil
+	result := (<-replyChan).(bstResult[T])
+	return result.value, result.err
 }
 
 func (bw *BSTWrapper[T]) Max() (T, error) {
 	replyChan := make(chan interface{})
 	bw.bst.bstChan <- bstRequest[T]{action: "max", replyChan: replyChan}
-	result := <-replyChan
-	if err, ok := result.(error); ok {
-		var zero T
-		return zero, err
-	}
-	return result.(T), nil
+	result := (<-replyChan).(bstResult[T])
+	return result.value, result.err
 }
 
 func (bw *BSTWrapper[T]) InOrder() []T {
@@ -103,3 +132,75 @@ func (bw *BSTWrapper[T]) Validate() bool {
 	bw.bst.bstChan <- bstRequest[T]{action: "validate", replyChan: replyChan}
 	return (<-replyChan).(bool)
 }
+
+func (bw *BSTWrapper[T]) nearest(action string, value T) (T, bool) {
+	replyChan := make(chan interface{})
+	bw.bst.bstChan <- bstRequest[T]{action: action, value: value, replyChan: replyChan}
+	result := (<-replyChan).(bstMatch[T])
+	return result.value, result.found
+}
+
+// Floor returns the greatest value less than or equal to value.
+func (bw *BSTWrapper[T]) Floor(value T) (T, bool) {
+	return bw.nearest("floor", value)
+}
+
+// Ceiling returns the least value greater than or equal to value.
+func (bw *BSTWrapper[T]) Ceiling(value T) (T, bool) {
+	return bw.nearest("ceiling", value)
+}
+
+// Predecessor returns the greatest value strictly less than value, which need
+// not be in the tree.
+func (bw *BSTWrapper[T]) Predecessor(value T) (T, bool) {
+	return bw.nearest("predecessor", value)
+}
+
+// Successor returns the least value strictly greater than value, which need
+// not be in the tree.
+func (bw *BSTWrapper[T]) Successor(value T) (T, bool) {
+	return bw.nearest("successor", value)
+}
+
+// RangeCount returns how many values lie in [lo, hi].
+func (bw *BSTWrapper[T]) RangeCount(lo, hi T) int {
+	replyChan := make(chan interface{})
+	bw.bst.bstChan <- bstRequest[T]{action: "rangeCount", value: lo, other: hi, replyChan: replyChan}
+	return (<-replyChan).(int)
+}
+
+// Range returns the values in [lo, hi] in ascending order.
+func (bw *BSTWrapper[T]) Range(lo, hi T) []T {
+	replyChan := make(chan interface{})
+	bw.bst.bstChan <- bstRequest[T]{action: "range", value: lo, other: hi, replyChan: replyChan}
+	return (<-replyChan).([]T)
+}
+
+func (bw *BSTWrapper[T]) orderStatistic(req bstRequest[T]) (T, error) {
+	req.replyChan = make(chan interface{})
+	bw.bst.bstChan <- req
+	result := (<-req.replyChan).(bstResult[T])
+	return result.value, result.err
+}
+
+// Select returns the k-th smallest value, counting from zero, in O(h).
+func (bw *BSTWrapper[T]) Select(k int) (T, error) {
+	return bw.orderStatistic(bstRequest[T]{action: "select", index: k})
+}
+
+// Rank returns how many values are less than value, in O(h).
+func (bw *BSTWrapper[T]) Rank(value T) int {
+	replyChan := make(chan interface{})
+	bw.bst.bstChan <- bstRequest[T]{action: "rank", value: value, replyChan: replyChan}
+	return (<-replyChan).(int)
+}
+
+// Median returns the lower median.
+func (bw *BSTWrapper[T]) Median() (T, error) {
+	return bw.orderStatistic(bstRequest[T]{action: "median"})
+}
+
+// Percentile returns the nearest-rank p-th percentile for p in [0, 100].
+func (bw *BSTWrapper[T]) Percentile(p float64) (T, error) {
+	return bw.orderStatistic(bstRequest[T]{action: "percentile", percentile: p})
+}
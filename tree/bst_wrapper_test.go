@@ -1,8 +1,11 @@
 package tree
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
+	"github.com/raj1kshtz/go-structurarium/datastructure_helper"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -148,3 +151,120 @@ func (s *BSTWrapperTestSuite) TestStringBSTWrapper() {
 	result := strWrapper.InOrder()
 	s.Equal([]string{"ant", "cat", "dog", "elephant"}, result)
 }
+
+func (s *BSTWrapperTestSuite) TestComparatorBSTWrapper() {
+	people := NewBSTWrapperFunc(comparePeople)
+	people.Insert(datastructure_helper.Person{ID: 20, Name: "Bob"})
+	people.Insert(datastructure_helper.Person{ID: 10, Name: "Alice"})
+	people.Insert(datastructure_helper.Person{ID: 30, Name: "Carol"})
+
+	s.Equal(3, people.Size())
+	s.True(people.Search(datastructure_helper.Person{ID: 10}))
+	first, err := people.Min()
+	s.NoError(err)
+	s.Equal("Alice", first.Name)
+	s.Equal([]datastructure_helper.Person{{ID: 10, Name: "Alice"}, {ID: 20, Name: "Bob"}, {ID: 30, Name: "Carol"}}, people.InOrder())
+	s.True(people.Validate())
+}
+
+func (s *BSTWrapperTestSuite) TestInterfaceValues() {
+	// Values that are themselves errors, or nil on a miss, must not be
+	// mistaken for the reply.
+	errs := NewBSTWrapperFunc(func(a, b error) int { return strings.Compare(a.Error(), b.Error()) })
+	_, ok := errs.Floor(errors.New("b"))
+	s.False(ok)
+	_, err := errs.Min()
+	s.Error(err)
+
+	errs.Insert(errors.New("b"))
+	errs.Insert(errors.New("a"))
+	first, err := errs.Min()
+	s.NoError(err)
+	s.EqualError(first, "a")
+	last, err := errs.Max()
+	s.NoError(err)
+	s.EqualError(last, "b")
+	floor, ok := errs.Floor(errors.New("az"))
+	s.True(ok)
+	s.EqualError(floor, "a")
+	median, err := errs.Median()
+	s.NoError(err)
+	s.EqualError(median, "a")
+	_, err = errs.Select(2)
+	s.Error(err)
+}
+
+func (s *BSTWrapperTestSuite) TestNavigation() {
+	for _, v := range []int{10, 20, 30, 40} {
+		s.bstWrapper.Insert(v)
+	}
+
+	floor, ok := s.bstWrapper.Floor(25)
+	s.True(ok)
+	s.Equal(20, floor)
+	ceiling, ok := s.bstWrapper.Ceiling(25)
+	s.True(ok)
+	s.Equal(30, ceiling)
+	_, ok = s.bstWrapper.Ceiling(41)
+	s.False(ok)
+
+	prev, ok := s.bstWrapper.Predecessor(20)
+	s.True(ok)
+	s.Equal(10, prev)
+	next, ok := s.bstWrapper.Successor(20)
+	s.True(ok)
+	s.Equal(30, next)
+	_, ok = s.bstWrapper.Predecessor(10)
+	s.False(ok)
+
+	s.Equal(3, s.bstWrapper.RangeCount(15, 40))
+	s.Equal([]int{20, 30, 40}, s.bstWrapper.Range(15, 40))
+}
+
+func (s *BSTWrapperTestSuite) TestOrderStatistics() {
+	for _, v := range []int{7, 3, 9, 1, 5} {
+		s.bstWrapper.Insert(v)
+	}
+
+	third, err := s.bstWrapper.Select(2)
+	s.NoError(err)
+	s.Equal(5, third)
+	_, err = s.bstWrapper.Select(5)
+	s.Error(err)
+	s.Equal(2, s.bstWrapper.Rank(4))
+
+	median, err := s.bstWrapper.Median()
+	s.NoError(err)
+	s.Equal(5, median)
+	p90, err := s.bstWrapper.Percentile(90)
+	s.NoError(err)
+	s.Equal(9, p90)
+	_, err = s.bstWrapper.Percentile(-1)
+	s.Error(err)
+
+	s.bstWrapper.Clear()
+	_, err = s.bstWrapper.Median()
+	s.Error(err)
+}
+
+func (s *BSTWrapperTestSuite) TestMultisetBSTWrapper() {
+	histogram := NewMultisetBSTWrapper[string]()
+	for _, word := range []string{"go", "is", "go", "fun", "go"} {
+		histogram.Insert(word)
+	}
+
+	s.Equal(5, histogram.Size())
+	s.Equal(3, histogram.Count("go"))
+	s.Equal([]string{"fun", "go", "go", "go", "is"}, histogram.InOrder())
+
+	s.True(histogram.Delete("go"))
+	s.Equal(2, histogram.Count("go"))
+	s.Equal(2, histogram.DeleteAll("go"))
+	s.Equal(0, histogram.Count("go"))
+	s.Equal(2, histogram.Size())
+
+	byID := NewMultisetBSTWrapperFunc(comparePeople)
+	byID.Insert(datastructure_helper.Person{ID: 1, Name: "Alice"})
+	byID.Insert(datastructure_helper.Person{ID: 1, Name: "Alias"})
+	s.Equal(2, byID.Count(datastructure_helper.Person{ID: 1}))
+}
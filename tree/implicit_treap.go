@@ -0,0 +1,211 @@
+package tree
+
+import (
+	"fmt"
+	"iter"
+	"math/rand/v2"
+)
+
+type implicitNode[T any] struct {
+	value    T
+	priority uint64
+	size     int
+	reversed bool
+	left     *implicitNode[T]
+	right    *implicitNode[T]
+}
+
+// ImplicitTreap is a sequence stored as a treap keyed by position instead of
+// by value. Inserting or removing at any index and reversing any range take
+// expected O(log n); reversals are applied lazily. It is not safe for
+// concurrent use.
+type ImplicitTreap[T any] struct {
+	root *implicitNode[T]
+	rng  *rand.Rand
+}
+
+func NewImplicitTreap[T any]() *ImplicitTreap[T] {
+	return NewImplicitTreapWithSeed[T](rand.Uint64())
+}
+
+// NewImplicitTreapWithSeed fixes the node priorities, so the same operations
+// always build the same tree.
+func NewImplicitTreapWithSeed[T any](seed uint64) *ImplicitTreap[T] {
+	return &ImplicitTreap[T]{rng: rand.New(rand.NewPCG(seed, seed))}
+}
+
+func implicitSize[T any](node *implicitNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
+func (n *implicitNode[T]) update() {
+	n.size = 1 + implicitSize(n.left) + implicitSize(n.right)
+}
+
+// push hands a pending reversal down to the children.
+func (n *implicitNode[T]) push() {
+	if !n.reversed {
+		return
+	}
+	n.left, n.right = n.right, n.left
+	if n.left != nil {
+		n.left.reversed = !n.left.reversed
+	}
+	if n.right != nil {
+		n.right.reversed = !n.right.reversed
+	}
+	n.reversed = false
+}
+
+// splitAt divides the subtree into its first count elements and the rest.
+func splitAt[T any](node *implicitNode[T], count int) (*implicitNode[T], *implicitNode[T]) {
+	if node == nil {
+		return nil, nil
+	}
+	node.push()
+	if implicitSize(node.left) < count {
+		left, right := splitAt(node.right, count-implicitSize(node.left)-1)
+		node.right = left
+		node.update()
+		return node, right
+	}
+	left, right := splitAt(node.left, count)
+	node.left = right
+	node.update()
+	return left, node
+}
+
+func joinImplicit[T any](a, b *implicitNode[T]) *implicitNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if a.priority > b.priority {
+		a.push()
+		a.right = joinImplicit(a.right, b)
+		a.update()
+		return a
+	}
+	b.push()
+	b.left = joinImplicit(a, b.left)
+	b.update()
+	return b
+}
+
+func (t *ImplicitTreap[T]) Len() int {
+	return implicitSize(t.root)
+}
+
+// PushBack appends value to the end of the sequence.
+func (t *ImplicitTreap[T]) PushBack(value T) {
+	node := &implicitNode[T]{value: value, priority: t.rng.Uint64(), size: 1}
+	t.root = joinImplicit(t.root, node)
+}
+
+// InsertAt inserts value so that it ends up at index; index may equal Len.
+func (t *ImplicitTreap[T]) InsertAt(index int, value T) error {
+	if index < 0 || index > t.Len() {
+		return fmt.Errorf("index %d out of range [0, %d]", index, t.Len())
+	}
+	left, right := splitAt(t.root, index)
+	node := &implicitNode[T]{value: value, priority: t.rng.Uint64(), size: 1}
+	t.root = joinImplicit(joinImplicit(left, node), right)
+	return nil
+}
+
+// RemoveAt removes and returns the element at index.
+func (t *ImplicitTreap[T]) RemoveAt(index int) (T, error) {
+	if index < 0 || index >= t.Len() {
+		var zero T
+		return zero, fmt.Errorf("index %d out of range [0, %d)", index, t.Len())
+	}
+	left, rest := splitAt(t.root, index)
+	match, right := splitAt(rest, 1)
+	t.root = joinImplicit(left, right)
+	return match.value, nil
+}
+
+// nodeAt returns the node at index, pushing pending reversals on the way.
+func (t *ImplicitTreap[T]) nodeAt(index int) *implicitNode[T] {
+	node := t.root
+	for {
+		node.push()
+		leftSize := implicitSize(node.left)
+		if index < leftSize {
+			node = node.left
+		} else if index == leftSize {
+			return node
+		} else {
+			index -= leftSize + 1
+			node = node.right
+		}
+	}
+}
+
+func (t *ImplicitTreap[T]) Get(index int) (T, error) {
+	if index < 0 || index >= t.Len() {
+		var zero T
+		return zero, fmt.Errorf("index %d out of range [0, %d)", index, t.Len())
+	}
+	return t.nodeAt(index).value, nil
+}
+
+func (t *ImplicitTreap[T]) Set(index int, value T) error {
+	if index < 0 || index >= t.Len() {
+		return fmt.Errorf("index %d out of range [0, %d)", index, t.Len())
+	}
+	t.nodeAt(index).value = value
+	return nil
+}
+
+// Reverse reverses the elements in [lo, hi).
+func (t *ImplicitTreap[T]) Reverse(lo, hi int) error {
+	if lo < 0 || hi > t.Len() || lo > hi {
+		return fmt.Errorf("range [%d, %d) out of range [0, %d]", lo, hi, t.Len())
+	}
+	left, rest := splitAt(t.root, lo)
+	middle, right := splitAt(rest, hi-lo)
+	if middle != nil {
+		middle.reversed = !middle.reversed
+	}
+	t.root = joinImplicit(joinImplicit(left, middle), right)
+	return nil
+}
+
+func (t *ImplicitTreap[T]) Clear() {
+	t.root = nil
+}
+
+// All yields the elements in sequence order with their indices.
+func (t *ImplicitTreap[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		var walk func(node *implicitNode[T]) bool
+		walk = func(node *implicitNode[T]) bool {
+			if node == nil {
+				return true
+			}
+			node.push()
+			if !walk(node.left) || !yield(index, node.value) {
+				return false
+			}
+			index++
+			return walk(node.right)
+		}
+		walk(t.root)
+	}
+}
+
+// Values returns the elements in sequence order.
+func (t *ImplicitTreap[T]) Values() []T {
+	result := make([]T, 0, t.Len())
+	for _, v := range t.All() {
+		result = append(result, v)
+	}
+	return result
+}
@@ -0,0 +1,116 @@
+package tree
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ImplicitTreapTestSuite struct {
+	suite.Suite
+	seq *ImplicitTreap[int]
+}
+
+func TestImplicitTreapTestSuite(t *testing.T) {
+	suite.Run(t, new(ImplicitTreapTestSuite))
+}
+
+func (s *ImplicitTreapTestSuite) SetupTest() {
+	s.seq = NewImplicitTreapWithSeed[int](9)
+	for i := 0; i < 6; i++ {
+		s.seq.PushBack(i)
+	}
+}
+
+func (s *ImplicitTreapTestSuite) TestInsertAndRemove() {
+	s.NoError(s.seq.InsertAt(0, -1))
+	s.NoError(s.seq.InsertAt(3, 100))
+	s.NoError(s.seq.InsertAt(s.seq.Len(), 6))
+	s.Equal([]int{-1, 0, 1, 100, 2, 3, 4, 5, 6}, s.seq.Values())
+	s.Error(s.seq.InsertAt(10, 0))
+	s.Error(s.seq.InsertAt(-1, 0))
+
+	v, err := s.seq.RemoveAt(3)
+	s.NoError(err)
+	s.Equal(100, v)
+	v, err = s.seq.RemoveAt(0)
+	s.NoError(err)
+	s.Equal(-1, v)
+	_, err = s.seq.RemoveAt(7)
+	s.Error(err)
+	s.Equal([]int{0, 1, 2, 3, 4, 5, 6}, s.seq.Values())
+	s.Equal(7, s.seq.Len())
+}
+
+func (s *ImplicitTreapTestSuite) TestGetSet() {
+	v, err := s.seq.Get(4)
+	s.NoError(err)
+	s.Equal(4, v)
+	s.NoError(s.seq.Set(4, 40))
+	v, _ = s.seq.Get(4)
+	s.Equal(40, v)
+	_, err = s.seq.Get(6)
+	s.Error(err)
+	s.Error(s.seq.Set(-1, 0))
+}
+
+func (s *ImplicitTreapTestSuite) TestReverse() {
+	s.NoError(s.seq.Reverse(1, 5))
+	s.Equal([]int{0, 4, 3, 2, 1, 5}, s.seq.Values())
+	s.NoError(s.seq.Reverse(0, 6))
+	s.Equal([]int{5, 1, 2, 3, 4, 0}, s.seq.Values())
+	s.NoError(s.seq.Reverse(2, 2))
+	s.Error(s.seq.Reverse(3, 2))
+	s.Error(s.seq.Reverse(0, 7))
+
+	// Lookups see through pending reversals.
+	s.NoError(s.seq.Reverse(0, 3))
+	v, _ := s.seq.Get(0)
+	s.Equal(2, v)
+	s.NoError(s.seq.InsertAt(1, 9))
+	s.Equal([]int{2, 9, 1, 5, 3, 4, 0}, s.seq.Values())
+}
+
+func (s *ImplicitTreapTestSuite) TestAllStopsEarly() {
+	seen := []int{}
+	for i, v := range s.seq.All() {
+		if i == 3 {
+			break
+		}
+		seen = append(seen, v)
+	}
+	s.Equal([]int{0, 1, 2}, seen)
+}
+
+func (s *ImplicitTreapTestSuite) TestRandomOperations() {
+	seq := NewImplicitTreapWithSeed[int](1)
+	reference := []int{}
+	rng := rand.New(rand.NewPCG(7, 8))
+	for i := 0; i < 3000; i++ {
+		switch op := rng.IntN(4); {
+		case op == 0 && len(reference) > 0:
+			index := rng.IntN(len(reference))
+			v, err := seq.RemoveAt(index)
+			s.NoError(err)
+			s.Equal(reference[index], v)
+			reference = slices.Delete(reference, index, index+1)
+		case op == 1:
+			lo := rng.IntN(len(reference) + 1)
+			hi := lo + rng.IntN(len(reference)-lo+1)
+			s.NoError(seq.Reverse(lo, hi))
+			slices.Reverse(reference[lo:hi])
+		default:
+			index := rng.IntN(len(reference) + 1)
+			s.NoError(seq.InsertAt(index, i))
+			reference = slices.Insert(reference, index, i)
+		}
+	}
+	s.Equal(len(reference), seq.Len())
+	s.Equal(reference, seq.Values())
+
+	seq.Clear()
+	s.Equal(0, seq.Len())
+	s.Empty(seq.Values())
+}
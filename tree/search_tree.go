@@ -0,0 +1,106 @@
+package tree
+
+// SearchTree is the ordered-set API shared by BSTWrapper, Treap and
+// SplayTree, so callers can swap one balancing strategy for another.
+type SearchTree[T any] interface {
+	Insert(value T)
+	Delete(value T) bool
+	Search(value T) bool
+	Min() (T, error)
+	Max() (T, error)
+	InOrder() []T
+	PreOrder() []T
+	PostOrder() []T
+	LevelOrder() []T
+	Height() int
+	Size() int
+	IsEmpty() bool
+	Clear()
+	Validate() bool
+}
+
+var (
+	_ SearchTree[int] = (*BSTWrapper[int])(nil)
+	_ SearchTree[int] = (*Treap[int])(nil)
+	_ SearchTree[int] = (*SplayTree[int])(nil)
+)
+
+// searchNode is the node type of Treap and SplayTree; priority and the
+// subtree size are only maintained by Treap.
+type searchNode[T any] struct {
+	value    T
+	priority uint64
+	size     int
+	left     *searchNode[T]
+	right    *searchNode[T]
+}
+
+func inOrderNodes[T any](node *searchNode[T], result *[]T) {
+	if node == nil {
+		return
+	}
+	inOrderNodes(node.left, result)
+	*result = append(*result, node.value)
+	inOrderNodes(node.right, result)
+}
+
+func preOrderNodes[T any](node *searchNode[T], result *[]T) {
+	if node == nil {
+		return
+	}
+	*result = append(*result, node.value)
+	preOrderNodes(node.left, result)
+	preOrderNodes(node.right, result)
+}
+
+func postOrderNodes[T any](node *searchNode[T], result *[]T) {
+	if node == nil {
+		return
+	}
+	postOrderNodes(node.left, result)
+	postOrderNodes(node.right, result)
+	*result = append(*result, node.value)
+}
+
+func levelOrderNodes[T any](root *searchNode[T]) []T {
+	result := make([]T, 0)
+	if root == nil {
+		return result
+	}
+	queue := []*searchNode[T]{root}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		result = append(result, node.value)
+		if node.left != nil {
+			queue = append(queue, node.left)
+		}
+		if node.right != nil {
+			queue = append(queue, node.right)
+		}
+	}
+	return result
+}
+
+func nodeHeight[T any](node *searchNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return max(nodeHeight(node.left), nodeHeight(node.right)) + 1
+}
+
+// orderedNodes reports whether the subtree is a strict search tree with every
+// value inside the open bounds.
+func orderedNodes[T any](node *searchNode[T], compare func(a, b T) int, min, max *T) bool {
+	if node == nil {
+		return true
+	}
+	if min != nil && compare(node.value, *min) <= 0 {
+		return false
+	}
+	if max != nil && compare(node.value, *max) >= 0 {
+		return false
+	}
+	return orderedNodes(node.left, compare, min, &node.value) &&
+		orderedNodes(node.right, compare, &node.value, max)
+}
@@ -0,0 +1,97 @@
+package tree
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// SearchTreeTestSuite runs the same checks against every SearchTree
+// implementation.
+type SearchTreeTestSuite struct {
+	suite.Suite
+	trees map[string]func() SearchTree[int]
+}
+
+func TestSearchTreeTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchTreeTestSuite))
+}
+
+func (s *SearchTreeTestSuite) SetupTest() {
+	s.trees = map[string]func() SearchTree[int]{
+		"BSTWrapper": func() SearchTree[int] { return NewBSTWrapper[int]() },
+		"Treap":      func() SearchTree[int] { return NewTreapWithSeed[int](1) },
+		"SplayTree":  func() SearchTree[int] { return NewSplayTree[int]() },
+	}
+}
+
+func (s *SearchTreeTestSuite) TestBasicOperations() {
+	for name, newTree := range s.trees {
+		tree := newTree()
+		s.True(tree.IsEmpty(), name)
+		_, err := tree.Min()
+		s.Error(err, name)
+		_, err = tree.Max()
+		s.Error(err, name)
+
+		for _, v := range []int{50, 30, 70, 20, 40, 60, 80, 30} {
+			tree.Insert(v)
+		}
+		s.Equal(7, tree.Size(), name)
+		s.True(tree.Search(40), name)
+		s.False(tree.Search(45), name)
+		s.Equal([]int{20, 30, 40, 50, 60, 70, 80}, tree.InOrder(), name)
+		s.Len(tree.PreOrder(), 7, name)
+		s.Len(tree.PostOrder(), 7, name)
+		s.Len(tree.LevelOrder(), 7, name)
+		s.True(tree.Validate(), name)
+
+		min, err := tree.Min()
+		s.NoError(err, name)
+		s.Equal(20, min, name)
+		max, err := tree.Max()
+		s.NoError(err, name)
+		s.Equal(80, max, name)
+
+		s.True(tree.Delete(50), name)
+		s.False(tree.Delete(50), name)
+		s.Equal([]int{20, 30, 40, 60, 70, 80}, tree.InOrder(), name)
+		s.True(tree.Validate(), name)
+
+		tree.Clear()
+		s.True(tree.IsEmpty(), name)
+		s.Equal(0, tree.Height(), name)
+		s.Empty(tree.InOrder(), name)
+	}
+}
+
+func (s *SearchTreeTestSuite) TestRandomOperations() {
+	for name, newTree := range s.trees {
+		tree := newTree()
+		reference := map[int]bool{}
+		rng := rand.New(rand.NewPCG(3, 4))
+		for i := 0; i < 2000; i++ {
+			v := rng.IntN(200)
+			switch rng.IntN(3) {
+			case 0:
+				s.Equal(reference[v], tree.Delete(v), name)
+				delete(reference, v)
+			case 1:
+				s.Equal(reference[v], tree.Search(v), name)
+			default:
+				tree.Insert(v)
+				reference[v] = true
+			}
+		}
+		want := make([]int, 0, len(reference))
+		for v := range reference {
+			want = append(want, v)
+		}
+		slices.Sort(want)
+		s.Equal(want, tree.InOrder(), name)
+		s.Equal(len(want), tree.Size(), name)
+		s.True(tree.Validate(), name)
+	}
+}
@@ -0,0 +1,205 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// SplayTree is a self-adjusting search tree: every Insert, Delete, Search,
+// Min and Max splays the value it touched to the root, so recently and
+// frequently accessed values stay cheap to reach. Operations take amortized
+// O(log n). Because reads restructure the tree, it is not safe for concurrent
+// use even by readers.
+type SplayTree[T any] struct {
+	root    *searchNode[T]
+	size    int
+	compare func(a, b T) int
+}
+
+func NewSplayTree[T cmp.Ordered]() *SplayTree[T] {
+	return NewSplayTreeFunc(cmp.Compare[T])
+}
+
+// NewSplayTreeFunc orders values with compare; see NewGenericBSTFunc.
+func NewSplayTreeFunc[T any](compare func(a, b T) int) *SplayTree[T] {
+	return &SplayTree[T]{compare: compare}
+}
+
+// splay performs a top-down splay of node around value and returns the new
+// root, which holds value if present and otherwise its last neighbour on the
+// search path.
+func (t *SplayTree[T]) splay(node *searchNode[T], value T) *searchNode[T] {
+	if node == nil {
+		return nil
+	}
+	var header searchNode[T]
+	left, right := &header, &header
+	for {
+		c := t.compare(value, node.value)
+		if c < 0 {
+			if node.left == nil {
+				break
+			}
+			if t.compare(value, node.left.value) < 0 {
+				// Zig-zig: rotate right before linking.
+				child := node.left
+				node.left = child.right
+				child.right = node
+				node = child
+				if node.left == nil {
+					break
+				}
+			}
+			right.left = node
+			right = node
+			node = node.left
+		} else if c > 0 {
+			if node.right == nil {
+				break
+			}
+			if t.compare(value, node.right.value) > 0 {
+				child := node.right
+				node.right = child.left
+				child.left = node
+				node = child
+				if node.right == nil {
+					break
+				}
+			}
+			left.right = node
+			left = node
+			node = node.right
+		} else {
+			break
+		}
+	}
+	left.right = node.left
+	right.left = node.right
+	node.left = header.right
+	node.right = header.left
+	return node
+}
+
+func (t *SplayTree[T]) Insert(value T) {
+	if t.root == nil {
+		t.root = &searchNode[T]{value: value}
+		t.size++
+		return
+	}
+	t.root = t.splay(t.root, value)
+	c := t.compare(value, t.root.value)
+	if c == 0 {
+		return
+	}
+	node := &searchNode[T]{value: value}
+	if c < 0 {
+		node.left = t.root.left
+		node.right = t.root
+		t.root.left = nil
+	} else {
+		node.right = t.root.right
+		node.left = t.root
+		t.root.right = nil
+	}
+	t.root = node
+	t.size++
+}
+
+func (t *SplayTree[T]) Delete(value T) bool {
+	if t.root == nil {
+		return false
+	}
+	t.root = t.splay(t.root, value)
+	if t.compare(value, t.root.value) != 0 {
+		return false
+	}
+	if t.root.left == nil {
+		t.root = t.root.right
+	} else {
+		// Splaying the left subtree for value brings its maximum to the top,
+		// which then has no right child.
+		right := t.root.right
+		t.root = t.splay(t.root.left, value)
+		t.root.right = right
+	}
+	t.size--
+	return true
+}
+
+func (t *SplayTree[T]) Search(value T) bool {
+	if t.root == nil {
+		return false
+	}
+	t.root = t.splay(t.root, value)
+	return t.compare(value, t.root.value) == 0
+}
+
+func (t *SplayTree[T]) Min() (T, error) {
+	if t.root == nil {
+		var zero T
+		return zero, fmt.Errorf("tree is empty")
+	}
+	node := t.root
+	for node.left != nil {
+		node = node.left
+	}
+	t.root = t.splay(t.root, node.value)
+	return t.root.value, nil
+}
+
+func (t *SplayTree[T]) Max() (T, error) {
+	if t.root == nil {
+		var zero T
+		return zero, fmt.Errorf("tree is empty")
+	}
+	node := t.root
+	for node.right != nil {
+		node = node.right
+	}
+	t.root = t.splay(t.root, node.value)
+	return t.root.value, nil
+}
+
+func (t *SplayTree[T]) InOrder() []T {
+	result := make([]T, 0, t.size)
+	inOrderNodes(t.root, &result)
+	return result
+}
+
+func (t *SplayTree[T]) PreOrder() []T {
+	result := make([]T, 0, t.size)
+	preOrderNodes(t.root, &result)
+	return result
+}
+
+func (t *SplayTree[T]) PostOrder() []T {
+	result := make([]T, 0, t.size)
+	postOrderNodes(t.root, &result)
+	return result
+}
+
+func (t *SplayTree[T]) LevelOrder() []T {
+	return levelOrderNodes(t.root)
+}
+
+func (t *SplayTree[T]) Height() int {
+	return nodeHeight(t.root)
+}
+
+func (t *SplayTree[T]) Size() int {
+	return t.size
+}
+
+func (t *SplayTree[T]) IsEmpty() bool {
+	return t.size == 0
+}
+
+func (t *SplayTree[T]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Validate checks the search-tree order and that Size matches the node count.
+func (t *SplayTree[T]) Validate() bool {
+	return orderedNodes(t.root, t.compare, nil, nil) && len(t.InOrder()) == t.size
+}
@@ -0,0 +1,121 @@
+package tree
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SplayTreeTestSuite struct {
+	suite.Suite
+	tree *SplayTree[int]
+}
+
+func TestSplayTreeTestSuite(t *testing.T) {
+	suite.Run(t, new(SplayTreeTestSuite))
+}
+
+func (s *SplayTreeTestSuite) SetupTest() {
+	s.tree = NewSplayTree[int]()
+	for _, v := range []int{50, 30, 70, 20, 40, 60, 80} {
+		s.tree.Insert(v)
+	}
+}
+
+func (s *SplayTreeTestSuite) TestAccessSplaysToRoot() {
+	s.True(s.tree.Search(40))
+	s.Equal(40, s.tree.root.value)
+
+	// A miss splays the last node on the search path.
+	s.False(s.tree.Search(65))
+	s.Contains([]int{60, 70}, s.tree.root.value)
+
+	_, err := s.tree.Min()
+	s.NoError(err)
+	s.Equal(20, s.tree.root.value)
+	_, err = s.tree.Max()
+	s.NoError(err)
+	s.Equal(80, s.tree.root.value)
+
+	s.tree.Insert(45)
+	s.Equal(45, s.tree.root.value)
+	s.True(s.tree.Validate())
+}
+
+func (s *SplayTreeTestSuite) TestRepeatedAccessIsShallow() {
+	tree := NewSplayTree[int]()
+	for i := 0; i < 1000; i++ {
+		tree.Insert(i)
+	}
+	// Sequential insertion leaves a path; a full scan of searches rebalances it.
+	for i := 0; i < 1000; i++ {
+		s.True(tree.Search(i))
+	}
+	s.True(tree.Search(500))
+	s.Equal(500, tree.root.value)
+	s.True(tree.Validate())
+	s.Equal(1000, tree.Size())
+}
+
+func (s *SplayTreeTestSuite) TestDeleteRoot() {
+	s.True(s.tree.Search(50))
+	s.True(s.tree.Delete(50))
+	s.Equal([]int{20, 30, 40, 60, 70, 80}, s.tree.InOrder())
+	s.True(s.tree.Delete(20))
+	s.True(s.tree.Validate())
+	s.Equal(5, s.tree.Size())
+
+	single := NewSplayTree[int]()
+	single.Insert(1)
+	s.True(single.Delete(1))
+	s.True(single.IsEmpty())
+	s.False(single.Delete(1))
+}
+
+func (s *SplayTreeTestSuite) TestRandomOperations() {
+	rng := rand.New(rand.NewPCG(7, 8))
+	tree := NewSplayTree[int]()
+	present := map[int]bool{}
+	for i := 0; i < 5000; i++ {
+		value := rng.IntN(300)
+		switch rng.IntN(4) {
+		case 0:
+			tree.Insert(value)
+			present[value] = true
+		case 1:
+			s.Equal(present[value], tree.Delete(value))
+			delete(present, value)
+		case 2:
+			s.Equal(present[value], tree.Search(value))
+		default:
+			if len(present) > 0 {
+				_, err := tree.Min()
+				s.NoError(err)
+			}
+		}
+		if i%100 == 0 {
+			s.Require().True(tree.Validate())
+		}
+	}
+	s.True(tree.Validate())
+	s.Equal(len(present), tree.Size())
+	want := make([]int, 0, len(present))
+	for value := range present {
+		want = append(want, value)
+	}
+	slices.Sort(want)
+	s.Equal(want, tree.InOrder())
+}
+
+func (s *SplayTreeTestSuite) TestComparator() {
+	tree := NewSplayTreeFunc(func(a, b string) int { return strings.Compare(b, a) })
+	for _, v := range []string{"b", "a", "c"} {
+		tree.Insert(v)
+	}
+	s.Equal([]string{"c", "b", "a"}, tree.InOrder())
+	min, _ := tree.Min()
+	s.Equal("c", min)
+}
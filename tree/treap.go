@@ -0,0 +1,236 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+)
+
+// Treap is a search tree that stays balanced in expectation by giving every
+// node a random priority and keeping priorities in heap order. Besides the
+// SearchTree API it can Split into two treaps around a value and Merge two
+// treaps whose ranges do not overlap, both in expected O(log n). It is not
+// safe for concurrent use.
+type Treap[T any] struct {
+	root    *searchNode[T]
+	size    int
+	compare func(a, b T) int
+	rng     *rand.Rand
+}
+
+func NewTreap[T cmp.Ordered]() *Treap[T] {
+	return NewTreapFuncWithSeed(cmp.Compare[T], rand.Uint64())
+}
+
+// NewTreapWithSeed fixes the node priorities, so the same operations always
+// build the same tree.
+func NewTreapWithSeed[T cmp.Ordered](seed uint64) *Treap[T] {
+	return NewTreapFuncWithSeed(cmp.Compare[T], seed)
+}
+
+// NewTreapFunc orders values with compare; see NewGenericBSTFunc.
+func NewTreapFunc[T any](compare func(a, b T) int) *Treap[T] {
+	return NewTreapFuncWithSeed(compare, rand.Uint64())
+}
+
+func NewTreapFuncWithSeed[T any](compare func(a, b T) int, seed uint64) *Treap[T] {
+	return &Treap[T]{compare: compare, rng: rand.New(rand.NewPCG(seed, seed))}
+}
+
+func nodeSize[T any](node *searchNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
+func (n *searchNode[T]) updateSize() *searchNode[T] {
+	n.size = nodeSize(n.left) + nodeSize(n.right) + 1
+	return n
+}
+
+// splitNode divides the subtree into values below value and the rest; with
+// inclusive, value itself also goes left.
+func (t *Treap[T]) splitNode(node *searchNode[T], value T, inclusive bool) (*searchNode[T], *searchNode[T]) {
+	if node == nil {
+		return nil, nil
+	}
+	if c := t.compare(node.value, value); c < 0 || (c == 0 && inclusive) {
+		left, right := t.splitNode(node.right, value, inclusive)
+		node.right = left
+		return node.updateSize(), right
+	}
+	left, right := t.splitNode(node.left, value, inclusive)
+	node.left = right
+	return left, node.updateSize()
+}
+
+// mergeNodes joins two subtrees where every value of a is below every value
+// of b.
+func mergeNodes[T any](a, b *searchNode[T]) *searchNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if a.priority > b.priority {
+		a.right = mergeNodes(a.right, b)
+		return a.updateSize()
+	}
+	b.left = mergeNodes(a, b.left)
+	return b.updateSize()
+}
+
+func (t *Treap[T]) Insert(value T) {
+	if t.Search(value) {
+		return
+	}
+	left, right := t.splitNode(t.root, value, false)
+	node := &searchNode[T]{value: value, priority: t.rng.Uint64(), size: 1}
+	t.root = mergeNodes(mergeNodes(left, node), right)
+	t.size++
+}
+
+func (t *Treap[T]) Delete(value T) bool {
+	left, rest := t.splitNode(t.root, value, false)
+	match, right := t.splitNode(rest, value, true)
+	t.root = mergeNodes(left, right)
+	if match == nil {
+		return false
+	}
+	t.size--
+	return true
+}
+
+func (t *Treap[T]) Search(value T) bool {
+	node := t.root
+	for node != nil {
+		c := t.compare(value, node.value)
+		if c == 0 {
+			return true
+		}
+		if c < 0 {
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+	return false
+}
+
+// Split moves the values less than value into left and the rest into right,
+// leaving t empty. Every node stores the size of its subtree, so both halves
+// know their Size without being walked.
+func (t *Treap[T]) Split(value T) (left, right *Treap[T]) {
+	l, r := t.splitNode(t.root, value, false)
+	left = &Treap[T]{root: l, size: nodeSize(l), compare: t.compare, rng: t.rng}
+	right = &Treap[T]{root: r, size: t.size - left.size, compare: t.compare, rng: rand.New(rand.NewPCG(t.rng.Uint64(), t.rng.Uint64()))}
+	t.root, t.size = nil, 0
+	return left, right
+}
+
+// Merge moves every value of other into t, leaving other empty. All values of
+// t must be less than all values of other.
+func (t *Treap[T]) Merge(other *Treap[T]) error {
+	if t.root != nil && other.root != nil {
+		high, _ := t.Max()
+		low, _ := other.Min()
+		if t.compare(high, low) >= 0 {
+			return fmt.Errorf("merge: max %v of the left treap is not less than min %v of the right", high, low)
+		}
+	}
+	t.root = mergeNodes(t.root, other.root)
+	t.size += other.size
+	other.root, other.size = nil, 0
+	return nil
+}
+
+func (t *Treap[T]) Min() (T, error) {
+	if t.root == nil {
+		var zero T
+		return zero, fmt.Errorf("tree is empty")
+	}
+	node := t.root
+	for node.left != nil {
+		node = node.left
+	}
+	return node.value, nil
+}
+
+func (t *Treap[T]) Max() (T, error) {
+	if t.root == nil {
+		var zero T
+		return zero, fmt.Errorf("tree is empty")
+	}
+	node := t.root
+	for node.right != nil {
+		node = node.right
+	}
+	return node.value, nil
+}
+
+func (t *Treap[T]) InOrder() []T {
+	result := make([]T, 0, t.size)
+	inOrderNodes(t.root, &result)
+	return result
+}
+
+func (t *Treap[T]) PreOrder() []T {
+	result := make([]T, 0, t.size)
+	preOrderNodes(t.root, &result)
+	return result
+}
+
+func (t *Treap[T]) PostOrder() []T {
+	result := make([]T, 0, t.size)
+	postOrderNodes(t.root, &result)
+	return result
+}
+
+func (t *Treap[T]) LevelOrder() []T {
+	return levelOrderNodes(t.root)
+}
+
+func (t *Treap[T]) Height() int {
+	return nodeHeight(t.root)
+}
+
+func (t *Treap[T]) Size() int {
+	return t.size
+}
+
+func (t *Treap[T]) IsEmpty() bool {
+	return t.size == 0
+}
+
+func (t *Treap[T]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Validate checks the search-tree order, the heap order of priorities and the
+// stored subtree sizes.
+func (t *Treap[T]) Validate() bool {
+	return orderedNodes(t.root, t.compare, nil, nil) && heapOrdered(t.root) && sizedNodes(t.root) && nodeSize(t.root) == t.size
+}
+
+func heapOrdered[T any](node *searchNode[T]) bool {
+	if node == nil {
+		return true
+	}
+	for _, child := range []*searchNode[T]{node.left, node.right} {
+		if child != nil && child.priority > node.priority {
+			return false
+		}
+	}
+	return heapOrdered(node.left) && heapOrdered(node.right)
+}
+
+func sizedNodes[T any](node *searchNode[T]) bool {
+	if node == nil {
+		return true
+	}
+	return node.size == nodeSize(node.left)+nodeSize(node.right)+1 &&
+		sizedNodes(node.left) && sizedNodes(node.right)
+}
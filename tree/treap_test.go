@@ -0,0 +1,153 @@
+package tree
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TreapTestSuite struct {
+	suite.Suite
+	treap *Treap[int]
+}
+
+func TestTreapTestSuite(t *testing.T) {
+	suite.Run(t, new(TreapTestSuite))
+}
+
+func (s *TreapTestSuite) SetupTest() {
+	s.treap = NewTreapWithSeed[int](42)
+	for i := 1; i <= 10; i++ {
+		s.treap.Insert(i * 10)
+	}
+}
+
+func (s *TreapTestSuite) TestHeapOrder() {
+	s.True(s.treap.Validate())
+	s.True(heapOrdered(s.treap.root))
+
+	// Sorted insertion would degenerate a plain BST into a list.
+	treap := NewTreapWithSeed[int](7)
+	for i := 0; i < 1000; i++ {
+		treap.Insert(i)
+	}
+	s.True(treap.Validate())
+	s.Less(treap.Height(), 50)
+}
+
+func (s *TreapTestSuite) TestSplit() {
+	left, right := s.treap.Split(55)
+	s.Equal([]int{10, 20, 30, 40, 50}, left.InOrder())
+	s.Equal([]int{60, 70, 80, 90, 100}, right.InOrder())
+	s.Equal(5, left.Size())
+	s.Equal(5, right.Size())
+	s.True(left.Validate())
+	s.True(right.Validate())
+	s.True(s.treap.IsEmpty())
+
+	// The split key itself goes right.
+	left, right = right.Split(80)
+	s.Equal([]int{60, 70}, left.InOrder())
+	s.Equal([]int{80, 90, 100}, right.InOrder())
+
+	left, right = right.Split(0)
+	s.True(left.IsEmpty())
+	s.Equal(3, right.Size())
+}
+
+func (s *TreapTestSuite) TestMerge() {
+	left, right := s.treap.Split(55)
+	s.Error(right.Merge(left))
+	s.Equal(5, right.Size())
+	s.Equal(5, left.Size())
+
+	s.NoError(left.Merge(right))
+	s.Equal([]int{10, 20, 30, 40, 50, 60, 70, 80, 90, 100}, left.InOrder())
+	s.Equal(10, left.Size())
+	s.True(left.Validate())
+	s.True(right.IsEmpty())
+
+	s.NoError(left.Merge(NewTreap[int]()))
+	s.Equal(10, left.Size())
+	empty := NewTreap[int]()
+	s.NoError(empty.Merge(left))
+	s.Equal(10, empty.Size())
+
+	// The merged treap keeps working.
+	empty.Insert(55)
+	s.True(empty.Delete(10))
+	s.Equal([]int{20, 30, 40, 50, 55, 60, 70, 80, 90, 100}, empty.InOrder())
+}
+
+func (s *TreapTestSuite) TestRepeatedSplitAndMerge() {
+	rng := rand.New(rand.NewPCG(3, 4))
+	treap := NewTreapWithSeed[int](11)
+	for i := 0; i < 500; i++ {
+		treap.Insert(i)
+	}
+	for round := 0; round < 200; round++ {
+		pivot := rng.IntN(520) - 10
+		left, right := treap.Split(pivot)
+		wantLeft := min(max(pivot, 0), 500)
+		s.Equal(wantLeft, left.Size())
+		s.Equal(500-wantLeft, right.Size())
+		s.Len(left.InOrder(), left.Size())
+		s.Len(right.InOrder(), right.Size())
+		s.True(left.Validate())
+		s.True(right.Validate())
+
+		s.Require().NoError(left.Merge(right))
+		s.Equal(500, left.Size())
+		s.True(left.Validate())
+		treap = left
+	}
+}
+
+func (s *TreapTestSuite) TestRandomOperations() {
+	rng := rand.New(rand.NewPCG(5, 6))
+	treap := NewTreapWithSeed[int](13)
+	present := map[int]bool{}
+	for i := 0; i < 5000; i++ {
+		value := rng.IntN(300)
+		switch rng.IntN(3) {
+		case 0:
+			treap.Insert(value)
+			present[value] = true
+		case 1:
+			s.Equal(present[value], treap.Delete(value))
+			delete(present, value)
+		default:
+			s.Equal(present[value], treap.Search(value))
+		}
+		if i%100 == 0 {
+			s.Require().True(treap.Validate())
+		}
+	}
+	s.True(treap.Validate())
+	s.Equal(len(present), treap.Size())
+	want := make([]int, 0, len(present))
+	for value := range present {
+		want = append(want, value)
+	}
+	slices.Sort(want)
+	s.Equal(want, treap.InOrder())
+}
+
+func (s *TreapTestSuite) TestComparator() {
+	treap := NewTreapFunc(func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	treap.Insert("banana")
+	treap.Insert("Apple")
+	treap.Insert("APPLE")
+	treap.Insert("cherry")
+	s.Equal([]string{"Apple", "banana", "cherry"}, treap.InOrder())
+	s.True(treap.Search("BANANA"))
+
+	left, right := treap.Split("B")
+	s.Equal([]string{"Apple"}, left.InOrder())
+	s.Equal([]string{"banana", "cherry"}, right.InOrder())
+}
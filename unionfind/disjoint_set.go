@@ -0,0 +1,139 @@
+package unionfind
+
+// DisjointSet partitions a collection of elements into disjoint sets. Find uses
+// path compression and Union uses union by rank, so any sequence of operations
+// runs in nearly linear time. It is not safe for concurrent use; see
+// DisjointSetWrapper.
+type DisjointSet[T comparable] struct {
+	parent map[T]T
+	rank   map[T]int
+	size   map[T]int
+	order  []T
+	sets   int
+}
+
+func NewDisjointSet[T comparable](elements ...T) *DisjointSet[T] {
+	ds := &DisjointSet[T]{
+		parent: make(map[T]T),
+		rank:   make(map[T]int),
+		size:   make(map[T]int),
+	}
+	for _, e := range elements {
+		ds.MakeSet(e)
+	}
+	return ds
+}
+
+// MakeSet adds x as a singleton set. It reports false if x is already known.
+func (ds *DisjointSet[T]) MakeSet(x T) bool {
+	if _, ok := ds.parent[x]; ok {
+		return false
+	}
+	ds.parent[x] = x
+	ds.size[x] = 1
+	ds.order = append(ds.order, x)
+	ds.sets++
+	return true
+}
+
+func (ds *DisjointSet[T]) Contains(x T) bool {
+	_, ok := ds.parent[x]
+	return ok
+}
+
+// Find returns the representative of the set containing x, compressing the
+// path on the way.
+func (ds *DisjointSet[T]) Find(x T) (T, bool) {
+	if _, ok := ds.parent[x]; !ok {
+		var zero T
+		return zero, false
+	}
+	root := x
+	for ds.parent[root] != root {
+		root = ds.parent[root]
+	}
+	for x != root {
+		next := ds.parent[x]
+		ds.parent[x] = root
+		x = next
+	}
+	return root, true
+}
+
+// Union merges the sets containing a and b, adding either element as a new
+// set first if it is unknown. It reports whether two different sets were
+// merged.
+func (ds *DisjointSet[T]) Union(a, b T) bool {
+	ds.MakeSet(a)
+	ds.MakeSet(b)
+	ra, _ := ds.Find(a)
+	rb, _ := ds.Find(b)
+	if ra == rb {
+		return false
+	}
+	if ds.rank[ra] < ds.rank[rb] {
+		ra, rb = rb, ra
+	}
+	ds.parent[rb] = ra
+	ds.size[ra] += ds.size[rb]
+	delete(ds.size, rb)
+	if ds.rank[ra] == ds.rank[rb] {
+		ds.rank[ra]++
+	}
+	delete(ds.rank, rb)
+	ds.sets--
+	return true
+}
+
+// Connected reports whether a and b are known and in the same set.
+func (ds *DisjointSet[T]) Connected(a, b T) bool {
+	ra, okA := ds.Find(a)
+	rb, okB := ds.Find(b)
+	return okA && okB && ra == rb
+}
+
+// SetSize returns the number of elements in the set containing x, or 0 if x
+// is unknown.
+func (ds *DisjointSet[T]) SetSize(x T) int {
+	root, ok := ds.Find(x)
+	if !ok {
+		return 0
+	}
+	return ds.size[root]
+}
+
+// Sets lists every set. Sets appear in the order their first element was
+// added and keep their elements in insertion order.
+func (ds *DisjointSet[T]) Sets() [][]T {
+	index := make(map[T]int, ds.sets)
+	sets := make([][]T, 0, ds.sets)
+	for _, x := range ds.order {
+		root, _ := ds.Find(x)
+		i, ok := index[root]
+		if !ok {
+			i = len(sets)
+			index[root] = i
+			sets = append(sets, nil)
+		}
+		sets[i] = append(sets[i], x)
+	}
+	return sets
+}
+
+// Size returns the number of elements.
+func (ds *DisjointSet[T]) Size() int {
+	return len(ds.parent)
+}
+
+// Count returns the number of disjoint sets.
+func (ds *DisjointSet[T]) Count() int {
+	return ds.sets
+}
+
+func (ds *DisjointSet[T]) Clear() {
+	ds.parent = make(map[T]T)
+	ds.rank = make(map[T]int)
+	ds.size = make(map[T]int)
+	ds.order = nil
+	ds.sets = 0
+}
@@ -0,0 +1,96 @@
+package unionfind
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DisjointSetTestSuite struct {
+	suite.Suite
+	ds *DisjointSet[int]
+}
+
+func TestDisjointSetTestSuite(t *testing.T) {
+	suite.Run(t, new(DisjointSetTestSuite))
+}
+
+func (s *DisjointSetTestSuite) SetupTest() {
+	s.ds = NewDisjointSet[int](1, 2, 3, 4, 5)
+}
+
+func (s *DisjointSetTestSuite) TestMakeSet() {
+	s.Equal(5, s.ds.Size())
+	s.Equal(5, s.ds.Count())
+	s.True(s.ds.MakeSet(6))
+	s.False(s.ds.MakeSet(6))
+	s.True(s.ds.Contains(6))
+	s.False(s.ds.Contains(7))
+	s.Equal(6, s.ds.Count())
+}
+
+func (s *DisjointSetTestSuite) TestFind() {
+	root, ok := s.ds.Find(3)
+	s.True(ok)
+	s.Equal(3, root)
+
+	_, ok = s.ds.Find(42)
+	s.False(ok)
+}
+
+func (s *DisjointSetTestSuite) TestUnion() {
+	s.True(s.ds.Union(1, 2))
+	s.True(s.ds.Union(3, 4))
+	s.False(s.ds.Union(2, 1))
+	s.Equal(3, s.ds.Count())
+
+	s.True(s.ds.Union(2, 4))
+	s.True(s.ds.Connected(1, 3))
+	s.False(s.ds.Connected(1, 5))
+	s.Equal(4, s.ds.SetSize(1))
+	s.Equal(1, s.ds.SetSize(5))
+	s.Equal(0, s.ds.SetSize(42))
+	s.Equal(2, s.ds.Count())
+
+	r1, _ := s.ds.Find(1)
+	r4, _ := s.ds.Find(4)
+	s.Equal(r1, r4)
+}
+
+func (s *DisjointSetTestSuite) TestUnionAddsUnknownElements() {
+	s.True(s.ds.Union(10, 11))
+	s.True(s.ds.Contains(10))
+	s.True(s.ds.Connected(10, 11))
+	s.Equal(7, s.ds.Size())
+	s.Equal(6, s.ds.Count())
+	s.False(s.ds.Connected(10, 99))
+}
+
+func (s *DisjointSetTestSuite) TestPathCompression() {
+	ds := NewDisjointSet[int]()
+	for i := 1; i < 1000; i++ {
+		ds.Union(i-1, i)
+	}
+	root, _ := ds.Find(999)
+	for i := 0; i < 1000; i++ {
+		ds.Find(i)
+		s.Equal(root, ds.parent[i])
+	}
+	s.LessOrEqual(ds.rank[root], 10)
+}
+
+func (s *DisjointSetTestSuite) TestSets() {
+	s.ds.Union(4, 1)
+	s.ds.Union(5, 3)
+	s.Equal([][]int{{1, 4}, {2}, {3, 5}}, s.ds.Sets())
+	s.Empty(NewDisjointSet[string]().Sets())
+}
+
+func (s *DisjointSetTestSuite) TestClear() {
+	s.ds.Union(1, 2)
+	s.ds.Clear()
+	s.Equal(0, s.ds.Size())
+	s.Equal(0, s.ds.Count())
+	s.False(s.ds.Contains(1))
+	s.True(s.ds.MakeSet(1))
+}
@@ -0,0 +1,113 @@
+package unionfind
+
+type disjointSetRequest[T comparable] struct {
+	action    string
+	value     T
+	other     T
+	replyChan chan interface{}
+}
+
+type findResult[T comparable] struct {
+	root  T
+	found bool
+}
+
+// DisjointSetWrapper serializes every operation on a DisjointSet through a
+// single goroutine so that it can be shared between goroutines.
+type DisjointSetWrapper[T comparable] struct {
+	requests chan disjointSetRequest[T]
+	ds       *DisjointSet[T]
+}
+
+func NewDisjointSetWrapper[T comparable](elements ...T) *DisjointSetWrapper[T] {
+	w := &DisjointSetWrapper[T]{
+		requests: make(chan disjointSetRequest[T]),
+		ds:       NewDisjointSet[T](elements...),
+	}
+	go w.manageDisjointSet()
+	return w
+}
+
+func (w *DisjointSetWrapper[T]) manageDisjointSet() {
+	for req := range w.requests {
+		switch req.action {
+		case "makeSet":
+			req.replyChan <- w.ds.MakeSet(req.value)
+		case "contains":
+			req.replyChan <- w.ds.Contains(req.value)
+		case "find":
+			root, found := w.ds.Find(req.value)
+			req.replyChan <- findResult[T]{root: root, found: found}
+		case "union":
+			req.replyChan <- w.ds.Union(req.value, req.other)
+		case "connected":
+			req.replyChan <- w.ds.Connected(req.value, req.other)
+		case "setSize":
+			req.replyChan <- w.ds.SetSize(req.value)
+		case "sets":
+			req.replyChan <- w.ds.Sets()
+		case "size":
+			req.replyChan <- w.ds.Size()
+		case "count":
+			req.replyChan <- w.ds.Count()
+		case "clear":
+			w.ds.Clear()
+			req.replyChan <- true
+		}
+	}
+}
+
+func (w *DisjointSetWrapper[T]) send(action string, value, other T) interface{} {
+	replyChan := make(chan interface{})
+	w.requests <- disjointSetRequest[T]{action: action, value: value, other: other, replyChan: replyChan}
+	return <-replyChan
+}
+
+func (w *DisjointSetWrapper[T]) MakeSet(x T) bool {
+	var zero T
+	return w.send("makeSet", x, zero).(bool)
+}
+
+func (w *DisjointSetWrapper[T]) Contains(x T) bool {
+	var zero T
+	return w.send("contains", x, zero).(bool)
+}
+
+func (w *DisjointSetWrapper[T]) Find(x T) (T, bool) {
+	var zero T
+	result := w.send("find", x, zero).(findResult[T])
+	return result.root, result.found
+}
+
+func (w *DisjointSetWrapper[T]) Union(a, b T) bool {
+	return w.send("union", a, b).(bool)
+}
+
+func (w *DisjointSetWrapper[T]) Connected(a, b T) bool {
+	return w.send("connected", a, b).(bool)
+}
+
+func (w *DisjointSetWrapper[T]) SetSize(x T) int {
+	var zero T
+	return w.send("setSize", x, zero).(int)
+}
+
+func (w *DisjointSetWrapper[T]) Sets() [][]T {
+	var zero T
+	return w.send("sets", zero, zero).([][]T)
+}
+
+func (w *DisjointSetWrapper[T]) Size() int {
+	var zero T
+	return w.send("size", zero, zero).(int)
+}
+
+func (w *DisjointSetWrapper[T]) Count() int {
+	var zero T
+	return w.send("count", zero, zero).(int)
+}
+
+func (w *DisjointSetWrapper[T]) Clear() {
+	var zero T
+	w.send("clear", zero, zero)
+}
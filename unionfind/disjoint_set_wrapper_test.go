@@ -0,0 +1,68 @@
+package unionfind
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DisjointSetWrapperTestSuite struct {
+	suite.Suite
+	wrapper *DisjointSetWrapper[string]
+}
+
+func TestDisjointSetWrapperTestSuite(t *testing.T) {
+	suite.Run(t, new(DisjointSetWrapperTestSuite))
+}
+
+func (s *DisjointSetWrapperTestSuite) SetupTest() {
+	s.wrapper = NewDisjointSetWrapper[string]("a", "b", "c")
+}
+
+func (s *DisjointSetWrapperTestSuite) TestOperations() {
+	s.True(s.wrapper.MakeSet("d"))
+	s.False(s.wrapper.MakeSet("a"))
+	s.True(s.wrapper.Contains("d"))
+
+	s.True(s.wrapper.Union("a", "b"))
+	s.False(s.wrapper.Union("b", "a"))
+	s.True(s.wrapper.Connected("a", "b"))
+	s.False(s.wrapper.Connected("a", "c"))
+
+	root, ok := s.wrapper.Find("b")
+	s.True(ok)
+	s.Contains([]string{"a", "b"}, root)
+	_, ok = s.wrapper.Find("z")
+	s.False(ok)
+
+	s.Equal(2, s.wrapper.SetSize("a"))
+	s.Equal(4, s.wrapper.Size())
+	s.Equal(3, s.wrapper.Count())
+	s.Equal([][]string{{"a", "b"}, {"c"}, {"d"}}, s.wrapper.Sets())
+}
+
+func (s *DisjointSetWrapperTestSuite) TestClear() {
+	s.wrapper.Union("a", "c")
+	s.wrapper.Clear()
+	s.Equal(0, s.wrapper.Size())
+	s.Empty(s.wrapper.Sets())
+}
+
+func (s *DisjointSetWrapperTestSuite) TestConcurrentUnions() {
+	w := NewDisjointSetWrapper[int]()
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(offset int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				w.Union(offset*100+i, (offset*100+i+1)%800)
+			}
+		}(g)
+	}
+	wg.Wait()
+	s.Equal(800, w.Size())
+	s.Equal(1, w.Count())
+	s.True(w.Connected(0, 799))
+}